@@ -3,11 +3,17 @@
 package coraxclient
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"mime/multipart"
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
 	"time"
 
 	api "terraform-provider-corax/internal/generated"
@@ -16,6 +22,9 @@ import (
 const (
 	defaultTimeout = 30 * time.Second
 	apiKeyHeader   = "X-API-Key"
+
+	// guardrailEventsPageSize is the maximum page size of GET /v1/compliance/guardrail-events.
+	guardrailEventsPageSize = 200
 )
 
 // Client manages communication with the Corax API.
@@ -36,6 +45,24 @@ type Client struct {
 
 	// generated is the underlying OpenAPI-generated client
 	generated *api.APIClient
+
+	// features holds the feature flags reported by GET /features.
+	// It is nil until Preflight has discovered them.
+	features map[string]bool
+
+	// configurationFields caches the configuration fields of model provider types for the lifetime of the client,
+	// i.e. one provider run. It is keyed by the kind of configuration (provider or deployment) and the provider type.
+	// configurationFieldsMu only guards the map, fetches run without holding it.
+	configurationFieldsMu sync.Mutex
+	configurationFields   map[string]*configurationFieldsEntry
+}
+
+// configurationFieldsEntry is a cached, or still in-flight, fetch of configuration fields.
+// done is closed once fields and err are set.
+type configurationFieldsEntry struct {
+	done   chan struct{}
+	fields []api.ConfigurationField
+	err    error
 }
 
 // NewClient returns a new Corax API client.
@@ -386,6 +413,38 @@ func (c *Client) DeleteProject(ctx context.Context, projectID string) error {
 	return nil
 }
 
+// ListProjects lists all projects visible to the caller, reading every page.
+// filter and sort are passed to the API as-is when not empty.
+// Corresponds to GET /v1/projects.
+func (c *Client) ListProjects(ctx context.Context, filter string, sort string) ([]Project, error) {
+	projects, err := listAllPages(func(page int32, size int32) ([]api.Project, int32, error) {
+		request := c.generated.ProjectsAPI.ListProjectsV1ProjectsGet(c.withAuth(ctx)).
+			Page(page).
+			Size(size)
+		if filter != "" {
+			request = request.Filter(filter)
+		}
+		if sort != "" {
+			request = request.Sort(sort)
+		}
+
+		result, resp, err := request.Execute()
+		if err != nil {
+			return nil, 0, convertError(err, resp)
+		}
+		return result.GetEmbedded(), result.Page.TotalPages, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]Project, 0, len(projects))
+	for i := range projects {
+		result = append(result, *convertProject(&projects[i]))
+	}
+	return result, nil
+}
+
 // convertProject converts a generated Project to our custom Project type.
 func convertProject(gen *api.Project) *Project {
 	if gen == nil {
@@ -425,8 +484,254 @@ func convertProject(gen *api.Project) *Project {
 	return result
 }
 
-// --- Collection Methods --- (REMOVED)
-// --- Document Methods --- (REMOVED)
+// --- Collection Methods ---
+
+// CreateCollection creates a new knowledge collection.
+// Corresponds to POST /v1/collections.
+func (c *Client) CreateCollection(ctx context.Context, collectionData CollectionCreate) (*Collection, error) {
+	genCreate := api.NewCollectionCreate(collectionData.Name)
+	if collectionData.Description != nil {
+		genCreate.SetDescription(*collectionData.Description)
+	}
+	if collectionData.IsPublic != nil {
+		genCreate.SetIsPublic(*collectionData.IsPublic)
+	}
+	if collectionData.ProjectID != nil {
+		genCreate.SetProjectId(*collectionData.ProjectID)
+	}
+
+	result, resp, err := c.generated.KnowledgeCollectionsAPI.CreateCollectionAsyncV1CollectionsPost(c.withAuth(ctx)).
+		CollectionCreate(*genCreate).
+		Execute()
+
+	if err != nil {
+		return nil, convertError(err, resp)
+	}
+
+	return convertCollection(result), nil
+}
+
+// GetCollection retrieves a specific knowledge collection by its ID.
+// Corresponds to GET /v1/collections/{collection_id}.
+func (c *Client) GetCollection(ctx context.Context, collectionID string) (*Collection, error) {
+	if strings.TrimSpace(collectionID) == "" {
+		return nil, fmt.Errorf("collectionID cannot be empty")
+	}
+
+	result, resp, err := c.generated.KnowledgeCollectionsAPI.ReadCollectionAsyncV1CollectionsCollectionIdGet(c.withAuth(ctx), collectionID).Execute()
+
+	if err != nil {
+		return nil, convertError(err, resp)
+	}
+
+	return convertCollection(result), nil
+}
+
+// UpdateCollection updates a specific knowledge collection by its ID.
+// Corresponds to PUT /v1/collections/{collection_id}.
+func (c *Client) UpdateCollection(ctx context.Context, collectionID string, collectionData CollectionUpdate) (*Collection, error) {
+	if strings.TrimSpace(collectionID) == "" {
+		return nil, fmt.Errorf("collectionID cannot be empty")
+	}
+
+	genUpdate := api.NewCollectionUpdate(collectionData.Name)
+	genUpdate.SetIsPublic(collectionData.IsPublic)
+	if collectionData.Description != nil {
+		genUpdate.SetDescription(*collectionData.Description)
+	} else {
+		genUpdate.SetDescriptionNil()
+	}
+	if collectionData.ProjectID != nil {
+		genUpdate.SetProjectId(*collectionData.ProjectID)
+	} else {
+		genUpdate.SetProjectIdNil()
+	}
+
+	result, resp, err := c.generated.KnowledgeCollectionsAPI.UpdateCollectionAsyncV1CollectionsCollectionIdPut(c.withAuth(ctx), collectionID).
+		CollectionUpdate(*genUpdate).
+		Execute()
+
+	if err != nil {
+		return nil, convertError(err, resp)
+	}
+
+	return convertCollection(result), nil
+}
+
+// DeleteCollection deletes a specific knowledge collection by its ID.
+// Corresponds to DELETE /v1/collections/{collection_id}.
+// Expects a 204 No Content on success.
+func (c *Client) DeleteCollection(ctx context.Context, collectionID string) error {
+	if strings.TrimSpace(collectionID) == "" {
+		return fmt.Errorf("collectionID cannot be empty")
+	}
+
+	resp, err := c.generated.KnowledgeCollectionsAPI.DeleteCollectionV1CollectionsCollectionIdDelete(c.withAuth(ctx), collectionID).Execute()
+	if err != nil {
+		return convertError(err, resp)
+	}
+	return nil
+}
+
+// convertCollection converts a generated Collection to our custom Collection type.
+func convertCollection(gen *api.Collection) *Collection {
+	if gen == nil {
+		return nil
+	}
+
+	result := &Collection{
+		ID:        gen.Id,
+		Name:      gen.Name,
+		IsPublic:  gen.GetIsPublic(),
+		CreatedBy: gen.CreatedBy,
+		UpdatedBy: gen.UpdatedBy,
+		CreatedAt: formatTime(gen.CreatedAt),
+		UpdatedAt: formatTime(gen.UpdatedAt),
+		Owner:     gen.Owner,
+	}
+
+	if gen.Description.IsSet() {
+		result.Description = gen.Description.Get()
+	}
+
+	if gen.ProjectId.IsSet() {
+		result.ProjectID = gen.ProjectId.Get()
+	}
+
+	if gen.DocumentsCount != nil {
+		result.DocumentsCount = int(*gen.DocumentsCount)
+	}
+
+	return result
+}
+
+// --- Document Methods ---
+
+// EmbedDocument uploads a single document to a knowledge collection and embeds it.
+// Corresponds to POST /v1/collections/{collection_id}/embed.
+// The generated client sends "files" as plain form values rather than file parts,
+// so the multipart request is built here directly.
+func (c *Client) EmbedDocument(ctx context.Context, collectionID string, upload DocumentUpload) (*api.DocumentEmbeddingResponse, error) {
+	if strings.TrimSpace(collectionID) == "" {
+		return nil, fmt.Errorf("collectionID cannot be empty")
+	}
+	if strings.TrimSpace(upload.Filename) == "" {
+		return nil, fmt.Errorf("filename cannot be empty")
+	}
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, err := writer.CreateFormFile("files", upload.Filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create multipart file part: %w", err)
+	}
+	if _, err := part.Write(upload.Content); err != nil {
+		return nil, fmt.Errorf("failed to write multipart file part: %w", err)
+	}
+	if upload.Language != nil {
+		if err := writer.WriteField("language", *upload.Language); err != nil {
+			return nil, fmt.Errorf("failed to write language field: %w", err)
+		}
+	}
+	if len(upload.DocumentMetadata) > 0 {
+		metadataJSON, err := json.Marshal(upload.DocumentMetadata)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode document_metadata: %w", err)
+		}
+		if err := writer.WriteField("document_metadata", string(metadataJSON)); err != nil {
+			return nil, fmt.Errorf("failed to write document_metadata field: %w", err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("failed to finalize multipart body: %w", err)
+	}
+
+	endpoint := c.BaseURL.JoinPath("v1", "collections", collectionID, "embed")
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.String(), body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", c.UserAgent)
+	req.Header.Set(apiKeyHeader, c.APIKey)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request to %s failed: %w", endpoint.String(), err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode >= 300 {
+		apiErr := &APIError{StatusCode: resp.StatusCode, Message: resp.Status, Body: respBody}
+		if resp.StatusCode == http.StatusNotFound {
+			apiErr.Message = "resource not found"
+		}
+		return nil, apiErr
+	}
+
+	var result api.DocumentEmbeddingResponse
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to decode embed response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// GetDocument retrieves a specific document in a knowledge collection by its ID.
+// Since there's no GET /v1/collections/{collection_id}/documents/{document_id} endpoint,
+// we use the list endpoint with a filter to find the specific document.
+func (c *Client) GetDocument(ctx context.Context, collectionID string, documentID string) (*api.Document, error) {
+	if strings.TrimSpace(collectionID) == "" {
+		return nil, fmt.Errorf("collectionID cannot be empty")
+	}
+	if strings.TrimSpace(documentID) == "" {
+		return nil, fmt.Errorf("documentID cannot be empty")
+	}
+
+	filter := fmt.Sprintf("id::%s", documentID)
+	result, resp, err := c.generated.KnowledgeCollectionsAPI.ReadCollectionDocumentsAsyncV1CollectionsCollectionIdDocumentsGet(c.withAuth(ctx), collectionID).
+		Filter(filter).
+		Size(100). // Get more results in case filter doesn't work perfectly
+		Execute()
+
+	if err != nil {
+		return nil, convertError(err, resp)
+	}
+
+	// Find the document with the matching ID (in case filter returns multiple results)
+	embedded := result.GetEmbedded()
+	for i := range embedded {
+		if embedded[i].GetId() == documentID {
+			return &embedded[i], nil
+		}
+	}
+
+	return nil, ErrNotFound
+}
+
+// DeleteDocument deletes a specific document from a knowledge collection.
+// Corresponds to DELETE /v1/collections/{collection_id}/documents/{document_id}.
+func (c *Client) DeleteDocument(ctx context.Context, collectionID string, documentID string) error {
+	if strings.TrimSpace(collectionID) == "" {
+		return fmt.Errorf("collectionID cannot be empty")
+	}
+	if strings.TrimSpace(documentID) == "" {
+		return fmt.Errorf("documentID cannot be empty")
+	}
+
+	resp, err := c.generated.KnowledgeCollectionsAPI.DeleteDocumentV1CollectionsCollectionIdDocumentsDocumentIdDelete(c.withAuth(ctx), collectionID, documentID).Execute()
+	if err != nil {
+		return convertError(err, resp)
+	}
+	return nil
+}
+
 // --- Embeddings Model Methods --- (REMOVED)
 
 // --- Capability Methods ---
@@ -557,6 +862,47 @@ func (c *Client) DeleteCapability(ctx context.Context, capabilityID string) erro
 	return nil
 }
 
+// ListCapabilities retrieves all capabilities of every type visible to the caller, reading every page.
+// filter is passed to the API as-is when not empty.
+// Corresponds to GET /v1/capabilities.
+func (c *Client) ListCapabilities(ctx context.Context, filter string) ([]api.Capability, error) {
+	return listAllPages(func(page int32, size int32) ([]api.Capability, int32, error) {
+		request := c.generated.CapabilitiesAPI.ListCapabilitiesV1CapabilitiesGet(c.withAuth(ctx)).
+			Page(page).
+			Size(size)
+		if filter != "" {
+			request = request.Filter(filter)
+		}
+
+		result, resp, err := request.Execute()
+		if err != nil {
+			return nil, 0, convertError(err, resp)
+		}
+		return result.GetEmbedded(), result.Page.TotalPages, nil
+	})
+}
+
+// ListCapabilityVersions retrieves all versions of a capability, reading every page.
+// Corresponds to GET /v1/capabilities/{capability_id}/versions.
+func (c *Client) ListCapabilityVersions(ctx context.Context, capabilityID string) ([]api.CapabilityVersion, error) {
+	if strings.TrimSpace(capabilityID) == "" {
+		return nil, fmt.Errorf("capabilityID cannot be empty")
+	}
+
+	capId := api.CapabilityId1{String: &capabilityID}
+
+	return listAllPages(func(page int32, size int32) ([]api.CapabilityVersion, int32, error) {
+		result, resp, err := c.generated.CapabilitiesAPI.ListCapabilityVersionsV1CapabilitiesCapabilityIdVersionsGet(c.withAuth(ctx), capId).
+			Page(page).
+			Size(size).
+			Execute()
+		if err != nil {
+			return nil, 0, convertError(err, resp)
+		}
+		return result.GetEmbedded(), result.Page.TotalPages, nil
+	})
+}
+
 // --- ModelDeployment Methods ---
 
 // convertSupportedTasksToGen converts []string to []api.CapabilityType.
@@ -726,6 +1072,30 @@ func (c *Client) DeleteModelDeployment(ctx context.Context, deploymentID string)
 	return nil
 }
 
+// ListModelDeployments retrieves all model deployments, reading every page.
+// Corresponds to GET /v1/model-deployments.
+func (c *Client) ListModelDeployments(ctx context.Context) ([]ModelDeployment, error) {
+	deployments, err := listAllPages(func(page int32, size int32) ([]api.ModelDeployment, int32, error) {
+		result, resp, err := c.generated.ModelDeploymentsAPI.ListModelDeploymentsV1ModelDeploymentsGet(c.withAuth(ctx)).
+			Page(page).
+			Size(size).
+			Execute()
+		if err != nil {
+			return nil, 0, convertError(err, resp)
+		}
+		return result.GetEmbedded(), result.Page.TotalPages, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]ModelDeployment, 0, len(deployments))
+	for i := range deployments {
+		result = append(result, *convertModelDeployment(&deployments[i]))
+	}
+	return result, nil
+}
+
 // --- ModelProvider Methods ---
 
 // convertModelProvider converts a generated ModelProvider to our custom type.
@@ -830,6 +1200,139 @@ func (c *Client) DeleteModelProvider(ctx context.Context, providerID string) err
 	return nil
 }
 
+// ListModelProviders retrieves all model providers, reading every page.
+// Corresponds to GET /v1/model-providers.
+func (c *Client) ListModelProviders(ctx context.Context) ([]ModelProvider, error) {
+	providers, err := listAllPages(func(page int32, size int32) ([]api.ModelProvider, int32, error) {
+		result, resp, err := c.generated.ModelProvidersAPI.ListModelProvidersV1ModelProvidersGet(c.withAuth(ctx)).
+			Page(page).
+			Size(size).
+			Execute()
+		if err != nil {
+			return nil, 0, convertError(err, resp)
+		}
+		return result.GetEmbedded(), result.Page.TotalPages, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]ModelProvider, 0, len(providers))
+	for i := range providers {
+		result = append(result, *convertModelProvider(&providers[i]))
+	}
+	return result, nil
+}
+
+// --- ModelProviderType Methods ---
+
+// GetModelProviderConfigurationFields retrieves the fields of the configuration of model providers
+// of the given type.
+// Corresponds to GET /v1/model-provider-types/{provider_type}/model-provider-configuration.
+func (c *Client) GetModelProviderConfigurationFields(ctx context.Context, providerType string) ([]api.ConfigurationField, error) {
+	if strings.TrimSpace(providerType) == "" {
+		return nil, fmt.Errorf("providerType cannot be empty")
+	}
+
+	result, resp, err := c.generated.ModelProviderTypesAPI.GetModelProviderConfigurationV1ModelProviderTypesProviderTypeModelProviderConfigurationGet(c.withAuth(ctx), providerType).Execute()
+	if err != nil {
+		return nil, convertError(err, resp)
+	}
+
+	return result.Configuration, nil
+}
+
+// GetModelDeploymentConfigurationFields retrieves the fields of the configuration of model deployments
+// of model providers of the given type.
+// Corresponds to GET /v1/model-provider-types/{provider_type}/model-deployment-configuration.
+func (c *Client) GetModelDeploymentConfigurationFields(ctx context.Context, providerType string) ([]api.ConfigurationField, error) {
+	if strings.TrimSpace(providerType) == "" {
+		return nil, fmt.Errorf("providerType cannot be empty")
+	}
+
+	result, resp, err := c.generated.ModelProviderTypesAPI.GetModelProviderDeploymentConfigurationV1ModelProviderTypesProviderTypeModelDeploymentConfigurationGet(c.withAuth(ctx), providerType).Execute()
+	if err != nil {
+		return nil, convertError(err, resp)
+	}
+
+	return result.Configuration, nil
+}
+
+// cachedConfigurationFields returns the cached fields for key, calling fetch on first use. Concurrent calls for
+// the same key share a single fetch, calls for other keys are not blocked by it. Errors are not cached.
+func (c *Client) cachedConfigurationFields(key string, fetch func() ([]api.ConfigurationField, error)) ([]api.ConfigurationField, error) {
+	c.configurationFieldsMu.Lock()
+	entry, ok := c.configurationFields[key]
+	if !ok {
+		entry = &configurationFieldsEntry{done: make(chan struct{})}
+		if c.configurationFields == nil {
+			c.configurationFields = map[string]*configurationFieldsEntry{}
+		}
+		c.configurationFields[key] = entry
+	}
+	c.configurationFieldsMu.Unlock()
+
+	if ok {
+		<-entry.done
+		return entry.fields, entry.err
+	}
+
+	entry.fields, entry.err = fetch()
+	if entry.err != nil {
+		c.configurationFieldsMu.Lock()
+		delete(c.configurationFields, key)
+		c.configurationFieldsMu.Unlock()
+	}
+	close(entry.done)
+	return entry.fields, entry.err
+}
+
+// CachedModelProviderConfigurationFields is GetModelProviderConfigurationFields, cached per provider type
+// for the lifetime of the client.
+func (c *Client) CachedModelProviderConfigurationFields(ctx context.Context, providerType string) ([]api.ConfigurationField, error) {
+	return c.cachedConfigurationFields("model-provider-configuration/"+providerType, func() ([]api.ConfigurationField, error) {
+		return c.GetModelProviderConfigurationFields(ctx, providerType)
+	})
+}
+
+// CachedModelDeploymentConfigurationFields is GetModelDeploymentConfigurationFields, cached per provider type
+// for the lifetime of the client.
+func (c *Client) CachedModelDeploymentConfigurationFields(ctx context.Context, providerType string) ([]api.ConfigurationField, error) {
+	return c.cachedConfigurationFields("model-deployment-configuration/"+providerType, func() ([]api.ConfigurationField, error) {
+		return c.GetModelDeploymentConfigurationFields(ctx, providerType)
+	})
+}
+
+// GetModelProviderType retrieves a model provider type by its name.
+// Corresponds to GET /v1/model-provider-types/{provider_type}.
+func (c *Client) GetModelProviderType(ctx context.Context, providerType string) (*api.ModelProviderType, error) {
+	if strings.TrimSpace(providerType) == "" {
+		return nil, fmt.Errorf("providerType cannot be empty")
+	}
+
+	result, resp, err := c.generated.ModelProviderTypesAPI.GetModelProviderTypeV1ModelProviderTypesProviderTypeGet(c.withAuth(ctx), providerType).Execute()
+	if err != nil {
+		return nil, convertError(err, resp)
+	}
+
+	return result, nil
+}
+
+// ListModelProviderTypes retrieves all model provider types, reading every page.
+// Corresponds to GET /v1/model-provider-types.
+func (c *Client) ListModelProviderTypes(ctx context.Context) ([]api.ModelProviderType, error) {
+	return listAllPages(func(page int32, size int32) ([]api.ModelProviderType, int32, error) {
+		result, resp, err := c.generated.ModelProviderTypesAPI.ListModelProviderTypesV1ModelProviderTypesGet(c.withAuth(ctx)).
+			Page(page).
+			Size(size).
+			Execute()
+		if err != nil {
+			return nil, 0, convertError(err, resp)
+		}
+		return result.GetEmbedded(), result.Page.TotalPages, nil
+	})
+}
+
 // CreateSpeechToTextCapability creates a new speech-to-text capability.
 // Corresponds to POST /v1/capabilities.
 func (c *Client) CreateSpeechToTextCapability(ctx context.Context, create api.SpeechToTextCapabilityCreate) (*api.SpeechToTextCapability, error) {
@@ -874,32 +1377,76 @@ func (c *Client) UpdateSpeechToTextCapability(ctx context.Context, capabilityID
 	return result, nil
 }
 
-// --- MCPServer Methods ---
+// CreateExtractionCapability creates a new extraction capability.
+// Corresponds to POST /v1/capabilities.
+func (c *Client) CreateExtractionCapability(ctx context.Context, create api.ExtractionCapabilityCreate) (*api.ExtractionCapability, error) {
+	cap1 := api.Capability1{ExtractionCapabilityCreate: &create}
 
-// convertMCPServer converts a generated MCPServerResponse to our custom MCPServer type.
-func convertMCPServer(gen *api.MCPServerResponse) *MCPServer {
-	if gen == nil {
-		return nil
-	}
+	result, resp, err := c.generated.CapabilitiesAPI.CreateCapabilityV1CapabilitiesPost(c.withAuth(ctx)).
+		Capability1(cap1).
+		Execute()
 
-	result := &MCPServer{
-		ID:       gen.Id,
-		Name:     gen.Name,
-		URL:      gen.Url,
-		Config:   gen.Config,
-		IsPublic: gen.GetIsPublic(),
-		Owner:    gen.Owner,
-		Slug:     gen.Slug,
+	if err != nil {
+		return nil, convertError(err, resp)
 	}
 
-	if gen.Type != nil {
-		result.Type = string(*gen.Type)
+	if result == nil {
+		return nil, fmt.Errorf("nil response from create capability")
+	}
+	if result.ExtractionCapability != nil {
+		return result.ExtractionCapability, nil
 	}
 
-	return result
+	return nil, fmt.Errorf("expected ExtractionCapability in response but got a different type")
 }
 
-// buildMCPServerBase constructs the generated MCPServerBase request payload from
+// UpdateExtractionCapability updates an extraction capability by its ID.
+// Corresponds to PUT /v1/capabilities/{capability_id}.
+func (c *Client) UpdateExtractionCapability(ctx context.Context, capabilityID string, update api.ExtractionCapabilityUpdate) (*api.CapabilityRepresentation, error) {
+	if strings.TrimSpace(capabilityID) == "" {
+		return nil, fmt.Errorf("capabilityID cannot be empty")
+	}
+
+	capId := api.CapabilityId1{String: &capabilityID}
+	cap2 := api.Capability2{ExtractionCapabilityUpdate: &update}
+
+	result, resp, err := c.generated.CapabilitiesAPI.UpdateCapabilityV1CapabilitiesCapabilityIdPut(c.withAuth(ctx), capId).
+		Capability2(cap2).
+		Execute()
+
+	if err != nil {
+		return nil, convertError(err, resp)
+	}
+
+	return result, nil
+}
+
+// --- MCPServer Methods ---
+
+// convertMCPServer converts a generated MCPServerResponse to our custom MCPServer type.
+func convertMCPServer(gen *api.MCPServerResponse) *MCPServer {
+	if gen == nil {
+		return nil
+	}
+
+	result := &MCPServer{
+		ID:       gen.Id,
+		Name:     gen.Name,
+		URL:      gen.Url,
+		Config:   gen.Config,
+		IsPublic: gen.GetIsPublic(),
+		Owner:    gen.Owner,
+		Slug:     gen.Slug,
+	}
+
+	if gen.Type != nil {
+		result.Type = string(*gen.Type)
+	}
+
+	return result
+}
+
+// buildMCPServerBase constructs the generated MCPServerBase request payload from
 // our custom struct. Used for both create (POST) and update (PUT), since the
 // API reuses the same body schema.
 func buildMCPServerBase(name, urlStr, typeStr string, config map[string]interface{}, isPublic *bool) (*api.MCPServerBase, error) {
@@ -1039,3 +1586,906 @@ func (c *Client) ListCapabilityTypes(ctx context.Context) (*api.CapabilityTypesR
 
 	return result, nil
 }
+
+// --- Evaluation Dataset Methods ---
+
+// CreateEvaluationDataset creates a new evaluation dataset.
+// Corresponds to POST /v1/evaluation-datasets.
+func (c *Client) CreateEvaluationDataset(ctx context.Context, create api.EvaluationDatasetCreate) (*api.EvaluationDatasetRepresentation, error) {
+	result, resp, err := c.generated.EvaluationDatasetsAPI.CreateEvaluationDatasetV1EvaluationDatasetsPost(c.withAuth(ctx)).
+		EvaluationDatasetCreate(create).
+		Execute()
+
+	if err != nil {
+		return nil, convertError(err, resp)
+	}
+
+	return result, nil
+}
+
+// GetEvaluationDataset retrieves a specific evaluation dataset by its ID.
+// Corresponds to GET /v1/evaluation-datasets/{dataset_id}.
+func (c *Client) GetEvaluationDataset(ctx context.Context, datasetID string) (*api.EvaluationDatasetRepresentation, error) {
+	if strings.TrimSpace(datasetID) == "" {
+		return nil, fmt.Errorf("datasetID cannot be empty")
+	}
+
+	result, resp, err := c.generated.EvaluationDatasetsAPI.GetEvaluationDatasetV1EvaluationDatasetsDatasetIdGet(c.withAuth(ctx), datasetID).Execute()
+
+	if err != nil {
+		return nil, convertError(err, resp)
+	}
+
+	return result, nil
+}
+
+// UpdateEvaluationDataset updates an existing evaluation dataset.
+// Corresponds to PUT /v1/evaluation-datasets/{dataset_id}.
+func (c *Client) UpdateEvaluationDataset(ctx context.Context, datasetID string, update api.EvaluationDatasetUpdate) (*api.EvaluationDatasetRepresentation, error) {
+	if strings.TrimSpace(datasetID) == "" {
+		return nil, fmt.Errorf("datasetID cannot be empty")
+	}
+
+	// The API requires the ID in the body as well as in the path.
+	update.SetId(datasetID)
+
+	result, resp, err := c.generated.EvaluationDatasetsAPI.UpdateEvaluationDatasetV1EvaluationDatasetsDatasetIdPut(c.withAuth(ctx), datasetID).
+		EvaluationDatasetUpdate(update).
+		Execute()
+
+	if err != nil {
+		return nil, convertError(err, resp)
+	}
+
+	return result, nil
+}
+
+// DeleteEvaluationDataset deletes a specific evaluation dataset by its ID.
+// Corresponds to DELETE /v1/evaluation-datasets/{dataset_id}.
+func (c *Client) DeleteEvaluationDataset(ctx context.Context, datasetID string) error {
+	if strings.TrimSpace(datasetID) == "" {
+		return fmt.Errorf("datasetID cannot be empty")
+	}
+
+	resp, err := c.generated.EvaluationDatasetsAPI.DeleteEvaluationDatasetV1EvaluationDatasetsDatasetIdDelete(c.withAuth(ctx), datasetID).Execute()
+	if err != nil {
+		return convertError(err, resp)
+	}
+	return nil
+}
+
+// AddEvaluationDatasetItems adds items to an evaluation dataset.
+// The endpoint accepts a single item per request, so items are posted one at a time
+// in order. If a request fails, the items created so far are returned with the error.
+// Corresponds to POST /v1/evaluation-datasets/{dataset_id}/items.
+func (c *Client) AddEvaluationDatasetItems(ctx context.Context, datasetID string, items []api.EvaluationDatasetItemCreate) ([]api.EvaluationDatasetItemRepresentation, error) {
+	if strings.TrimSpace(datasetID) == "" {
+		return nil, fmt.Errorf("datasetID cannot be empty")
+	}
+
+	created := make([]api.EvaluationDatasetItemRepresentation, 0, len(items))
+	for i := range items {
+		result, resp, err := c.generated.EvaluationDatasetsAPI.AddItemsToEvaluationDatasetV1EvaluationDatasetsDatasetIdItemsPost(c.withAuth(ctx), datasetID).
+			EvaluationDatasetItemCreate(items[i]).
+			Execute()
+
+		if err != nil {
+			return created, convertError(err, resp)
+		}
+		if result == nil {
+			return created, fmt.Errorf("nil response when adding evaluation dataset item %d", i)
+		}
+		created = append(created, *result)
+	}
+
+	return created, nil
+}
+
+// GetEvaluationDatasetItem retrieves a specific item of an evaluation dataset.
+// Corresponds to GET /v1/evaluation-datasets/{dataset_id}/items/{item_id}.
+func (c *Client) GetEvaluationDatasetItem(ctx context.Context, datasetID string, itemID string) (*api.EvaluationDatasetItemRepresentation, error) {
+	if strings.TrimSpace(datasetID) == "" {
+		return nil, fmt.Errorf("datasetID cannot be empty")
+	}
+	if strings.TrimSpace(itemID) == "" {
+		return nil, fmt.Errorf("itemID cannot be empty")
+	}
+
+	result, resp, err := c.generated.EvaluationDatasetsAPI.GetEvaluationDatasetItemV1EvaluationDatasetsDatasetIdItemsItemIdGet(c.withAuth(ctx), datasetID, itemID).Execute()
+
+	if err != nil {
+		return nil, convertError(err, resp)
+	}
+
+	return result, nil
+}
+
+// ListEvaluationDatasetItems retrieves all items of an evaluation dataset, following pagination.
+// Corresponds to GET /v1/evaluation-datasets/{dataset_id}/items.
+func (c *Client) ListEvaluationDatasetItems(ctx context.Context, datasetID string) ([]api.EvaluationDatasetItemRepresentation, error) {
+	if strings.TrimSpace(datasetID) == "" {
+		return nil, fmt.Errorf("datasetID cannot be empty")
+	}
+
+	return listAllPages(func(page int32, size int32) ([]api.EvaluationDatasetItemRepresentation, int32, error) {
+		result, resp, err := c.generated.EvaluationDatasetsAPI.ListEvaluationDatasetItemsV1EvaluationDatasetsDatasetIdItemsGet(c.withAuth(ctx), datasetID).
+			Page(page).
+			Size(size).
+			Execute()
+		if err != nil {
+			return nil, 0, convertError(err, resp)
+		}
+		return result.GetEmbedded(), result.Page.TotalPages, nil
+	})
+}
+
+// UpdateEvaluationDatasetItem updates an existing item of an evaluation dataset.
+// Corresponds to PUT /v1/evaluation-datasets/{dataset_id}/items/{item_id}.
+func (c *Client) UpdateEvaluationDatasetItem(ctx context.Context, datasetID string, itemID string, update api.EvaluationDatasetItemUpdate) (*api.EvaluationDatasetItemRepresentation, error) {
+	if strings.TrimSpace(datasetID) == "" {
+		return nil, fmt.Errorf("datasetID cannot be empty")
+	}
+	if strings.TrimSpace(itemID) == "" {
+		return nil, fmt.Errorf("itemID cannot be empty")
+	}
+
+	// The API requires the ID in the body as well as in the path.
+	update.SetId(itemID)
+
+	result, resp, err := c.generated.EvaluationDatasetsAPI.UpdateEvaluationDatasetItemV1EvaluationDatasetsDatasetIdItemsItemIdPut(c.withAuth(ctx), datasetID, itemID).
+		EvaluationDatasetItemUpdate(update).
+		Execute()
+
+	if err != nil {
+		return nil, convertError(err, resp)
+	}
+
+	return result, nil
+}
+
+// DeleteEvaluationDatasetItem deletes a specific item from an evaluation dataset.
+// Corresponds to DELETE /v1/evaluation-datasets/{dataset_id}/items/{item_id}.
+func (c *Client) DeleteEvaluationDatasetItem(ctx context.Context, datasetID string, itemID string) error {
+	if strings.TrimSpace(datasetID) == "" {
+		return fmt.Errorf("datasetID cannot be empty")
+	}
+	if strings.TrimSpace(itemID) == "" {
+		return fmt.Errorf("itemID cannot be empty")
+	}
+
+	resp, err := c.generated.EvaluationDatasetsAPI.DeleteEvaluationDatasetItemV1EvaluationDatasetsDatasetIdItemsItemIdDelete(c.withAuth(ctx), datasetID, itemID).Execute()
+	if err != nil {
+		return convertError(err, resp)
+	}
+	return nil
+}
+
+// --- Capability Evaluation Methods ---
+
+// CreateCapabilityEvaluation creates a new capability evaluation.
+// Corresponds to POST /v1/capability-evaluations.
+func (c *Client) CreateCapabilityEvaluation(ctx context.Context, create api.CapabilityEvaluationCreate) (*api.CapabilityEvaluationRepresentation, error) {
+	result, resp, err := c.generated.CapabilityEvaluationsAPI.CreateEvaluationV1CapabilityEvaluationsPost(c.withAuth(ctx)).
+		CapabilityEvaluationCreate(create).
+		Execute()
+
+	if err != nil {
+		return nil, convertError(err, resp)
+	}
+
+	return result, nil
+}
+
+// GetCapabilityEvaluation retrieves a specific capability evaluation by its ID.
+// Corresponds to GET /v1/capability-evaluations/{evaluation_id}.
+func (c *Client) GetCapabilityEvaluation(ctx context.Context, evaluationID string) (*api.CapabilityEvaluationRepresentation, error) {
+	if strings.TrimSpace(evaluationID) == "" {
+		return nil, fmt.Errorf("evaluationID cannot be empty")
+	}
+
+	result, resp, err := c.generated.CapabilityEvaluationsAPI.GetEvaluationV1CapabilityEvaluationsEvaluationIdGet(c.withAuth(ctx), evaluationID).Execute()
+
+	if err != nil {
+		return nil, convertError(err, resp)
+	}
+
+	return result, nil
+}
+
+// UpdateCapabilityEvaluation updates an existing capability evaluation.
+// Corresponds to PUT /v1/capability-evaluations/{evaluation_id}.
+func (c *Client) UpdateCapabilityEvaluation(ctx context.Context, evaluationID string, update api.CapabilityEvaluationUpdate) (*api.CapabilityEvaluationRepresentation, error) {
+	if strings.TrimSpace(evaluationID) == "" {
+		return nil, fmt.Errorf("evaluationID cannot be empty")
+	}
+
+	result, resp, err := c.generated.CapabilityEvaluationsAPI.UpdateEvaluationV1CapabilityEvaluationsEvaluationIdPut(c.withAuth(ctx), evaluationID).
+		CapabilityEvaluationUpdate(update).
+		Execute()
+
+	if err != nil {
+		return nil, convertError(err, resp)
+	}
+
+	return result, nil
+}
+
+// DeleteCapabilityEvaluation deletes a specific capability evaluation by its ID.
+// Corresponds to DELETE /v1/capability-evaluations/{evaluation_id}.
+func (c *Client) DeleteCapabilityEvaluation(ctx context.Context, evaluationID string) error {
+	if strings.TrimSpace(evaluationID) == "" {
+		return fmt.Errorf("evaluationID cannot be empty")
+	}
+
+	resp, err := c.generated.CapabilityEvaluationsAPI.DeleteEvaluationV1CapabilityEvaluationsEvaluationIdDelete(c.withAuth(ctx), evaluationID).Execute()
+	if err != nil {
+		return convertError(err, resp)
+	}
+	return nil
+}
+
+// CreateEvaluationCriterion adds a criterion to a capability evaluation.
+// Corresponds to POST /v1/capability-evaluations/{evaluation_id}/criteria.
+func (c *Client) CreateEvaluationCriterion(ctx context.Context, evaluationID string, create api.CapabilityEvaluationCriterionCreate) (*api.CapabilityEvaluationCriterionRepresentation, error) {
+	if strings.TrimSpace(evaluationID) == "" {
+		return nil, fmt.Errorf("evaluationID cannot be empty")
+	}
+
+	result, resp, err := c.generated.CapabilityEvaluationsAPI.CreateEvaluationCriterionV1CapabilityEvaluationsEvaluationIdCriteriaPost(c.withAuth(ctx), evaluationID).
+		CapabilityEvaluationCriterionCreate(create).
+		Execute()
+
+	if err != nil {
+		return nil, convertError(err, resp)
+	}
+
+	return result, nil
+}
+
+// ListEvaluationCriteria retrieves all criteria of a capability evaluation, following pagination.
+// Corresponds to GET /v1/capability-evaluations/{evaluation_id}/criteria.
+func (c *Client) ListEvaluationCriteria(ctx context.Context, evaluationID string) ([]api.CapabilityEvaluationCriterionRepresentation, error) {
+	if strings.TrimSpace(evaluationID) == "" {
+		return nil, fmt.Errorf("evaluationID cannot be empty")
+	}
+
+	return listAllPages(func(page int32, size int32) ([]api.CapabilityEvaluationCriterionRepresentation, int32, error) {
+		result, resp, err := c.generated.CapabilityEvaluationsAPI.GetEvaluationCriteriaV1CapabilityEvaluationsEvaluationIdCriteriaGet(c.withAuth(ctx), evaluationID).
+			Page(page).
+			Size(size).
+			Execute()
+		if err != nil {
+			return nil, 0, convertError(err, resp)
+		}
+		return result.GetEmbedded(), result.Page.TotalPages, nil
+	})
+}
+
+// UpdateEvaluationCriterion updates a criterion of a capability evaluation.
+// Corresponds to PUT /v1/capability-evaluations/{evaluation_id}/criteria/{criterion_id}.
+func (c *Client) UpdateEvaluationCriterion(ctx context.Context, evaluationID string, criterionID string, update api.CapabilityEvaluationCriterionCreate) (*api.CapabilityEvaluationCriterionRepresentation, error) {
+	if strings.TrimSpace(evaluationID) == "" {
+		return nil, fmt.Errorf("evaluationID cannot be empty")
+	}
+	if strings.TrimSpace(criterionID) == "" {
+		return nil, fmt.Errorf("criterionID cannot be empty")
+	}
+
+	result, resp, err := c.generated.CapabilityEvaluationsAPI.UpdateEvaluationCriterionV1CapabilityEvaluationsEvaluationIdCriteriaCriterionIdPut(c.withAuth(ctx), evaluationID, criterionID).
+		CapabilityEvaluationCriterionCreate(update).
+		Execute()
+
+	if err != nil {
+		return nil, convertError(err, resp)
+	}
+
+	return result, nil
+}
+
+// DeleteEvaluationCriterion deletes a criterion from a capability evaluation.
+// Corresponds to DELETE /v1/capability-evaluations/{evaluation_id}/criteria/{criterion_id}.
+func (c *Client) DeleteEvaluationCriterion(ctx context.Context, evaluationID string, criterionID string) error {
+	if strings.TrimSpace(evaluationID) == "" {
+		return fmt.Errorf("evaluationID cannot be empty")
+	}
+	if strings.TrimSpace(criterionID) == "" {
+		return fmt.Errorf("criterionID cannot be empty")
+	}
+
+	resp, err := c.generated.CapabilityEvaluationsAPI.DeleteEvaluationCriterionV1CapabilityEvaluationsEvaluationIdCriteriaCriterionIdDelete(c.withAuth(ctx), evaluationID, criterionID).Execute()
+	if err != nil {
+		return convertError(err, resp)
+	}
+	return nil
+}
+
+// ListEvaluationCriterionTypes retrieves all evaluation criterion type definitions, following pagination.
+// Corresponds to GET /v1/criterion-types.
+func (c *Client) ListEvaluationCriterionTypes(ctx context.Context) ([]api.CapabilityEvaluationCriterionTypeRepresentation, error) {
+	return listAllPages(func(page int32, size int32) ([]api.CapabilityEvaluationCriterionTypeRepresentation, int32, error) {
+		result, resp, err := c.generated.CriterionTypesAPI.ListEvaluationCriteriaTypesV1CriterionTypesGet(c.withAuth(ctx)).
+			Page(page).
+			Size(size).
+			Execute()
+		if err != nil {
+			return nil, 0, convertError(err, resp)
+		}
+		return result.GetEmbedded(), result.Page.TotalPages, nil
+	})
+}
+
+// --- Evaluation Execution Methods ---
+
+// ExecuteCapabilityEvaluation starts an execution of a capability evaluation.
+// Corresponds to POST /v1/capability-evaluations/{evaluation_id}/executions.
+func (c *Client) ExecuteCapabilityEvaluation(ctx context.Context, evaluationID string, execution api.EvaluationExecutionCreate) (*api.EvaluationExecutionRepresentation, error) {
+	if strings.TrimSpace(evaluationID) == "" {
+		return nil, fmt.Errorf("evaluationID cannot be empty")
+	}
+
+	result, resp, err := c.generated.CapabilityEvaluationsAPI.ExecuteEvaluationV1CapabilityEvaluationsEvaluationIdExecutionsPost(c.withAuth(ctx), evaluationID).
+		EvaluationExecutionCreate(execution).
+		Execute()
+
+	if err != nil {
+		return nil, convertError(err, resp)
+	}
+
+	return result, nil
+}
+
+// GetEvaluationExecution retrieves an execution of a capability evaluation, including its progress.
+// Corresponds to GET /v1/capability-evaluations/{evaluation_id}/executions/{evaluation_execution_id}.
+func (c *Client) GetEvaluationExecution(ctx context.Context, evaluationID string, executionID string) (*api.EvaluationExecutionRepresentation, error) {
+	if strings.TrimSpace(evaluationID) == "" {
+		return nil, fmt.Errorf("evaluationID cannot be empty")
+	}
+	if strings.TrimSpace(executionID) == "" {
+		return nil, fmt.Errorf("executionID cannot be empty")
+	}
+
+	result, resp, err := c.generated.CapabilityEvaluationsAPI.GetEvaluationExecutionV1CapabilityEvaluationsEvaluationIdExecutionsEvaluationExecutionIdGet(c.withAuth(ctx), evaluationID, executionID).Execute()
+	if err != nil {
+		return nil, convertError(err, resp)
+	}
+
+	return result, nil
+}
+
+// ListEvaluationCriterionExecutions retrieves the per-criterion results of an evaluation execution, following pagination.
+// Corresponds to GET /v1/capability-evaluations/{evaluation_id}/executions/{evaluation_execution_id}/criteria-executions.
+func (c *Client) ListEvaluationCriterionExecutions(ctx context.Context, evaluationID string, executionID string) ([]api.EvaluationCriterionExecutionRepresentation, error) {
+	if strings.TrimSpace(evaluationID) == "" {
+		return nil, fmt.Errorf("evaluationID cannot be empty")
+	}
+	if strings.TrimSpace(executionID) == "" {
+		return nil, fmt.Errorf("executionID cannot be empty")
+	}
+
+	return listAllPages(func(page int32, size int32) ([]api.EvaluationCriterionExecutionRepresentation, int32, error) {
+		result, resp, err := c.generated.CapabilityEvaluationsAPI.GetEvaluationExecutionCriteriaExecutionsV1CapabilityEvaluationsEvaluationIdExecutionsEvaluationExecutionIdCriteriaExecutionsGet(c.withAuth(ctx), evaluationID, executionID).
+			Page(page).
+			Size(size).
+			Execute()
+		if err != nil {
+			return nil, 0, convertError(err, resp)
+		}
+		return result.GetEmbedded(), result.Page.TotalPages, nil
+	})
+}
+
+// --- Compliance Policy Methods ---
+
+// CreateCompliancePolicy creates a new compliance policy.
+// Corresponds to POST /v1/compliance/policies.
+func (c *Client) CreateCompliancePolicy(ctx context.Context, policy api.PolicyCreate) (*api.PolicyResponse, error) {
+	result, resp, err := c.generated.ComplianceAPI.CreatePolicyV1CompliancePoliciesPost(c.withAuth(ctx)).
+		PolicyCreate(policy).
+		Execute()
+
+	if err != nil {
+		return nil, convertError(err, resp)
+	}
+
+	return result, nil
+}
+
+// GetCompliancePolicy retrieves a specific compliance policy by its ID.
+// Corresponds to GET /v1/compliance/policies/{policy_id}.
+func (c *Client) GetCompliancePolicy(ctx context.Context, policyID string) (*api.PolicyResponse, error) {
+	if strings.TrimSpace(policyID) == "" {
+		return nil, fmt.Errorf("policyID cannot be empty")
+	}
+
+	result, resp, err := c.generated.ComplianceAPI.GetPolicyV1CompliancePoliciesPolicyIdGet(c.withAuth(ctx), policyID).Execute()
+	if err != nil {
+		return nil, convertError(err, resp)
+	}
+
+	return result, nil
+}
+
+// UpdateCompliancePolicy partially updates an existing compliance policy.
+// Corresponds to PATCH /v1/compliance/policies/{policy_id}.
+func (c *Client) UpdateCompliancePolicy(ctx context.Context, policyID string, update api.PolicyUpdate) (*api.PolicyResponse, error) {
+	if strings.TrimSpace(policyID) == "" {
+		return nil, fmt.Errorf("policyID cannot be empty")
+	}
+
+	result, resp, err := c.generated.ComplianceAPI.UpdatePolicyV1CompliancePoliciesPolicyIdPatch(c.withAuth(ctx), policyID).
+		PolicyUpdate(update).
+		Execute()
+
+	if err != nil {
+		return nil, convertError(err, resp)
+	}
+
+	return result, nil
+}
+
+// DeleteCompliancePolicy deletes a compliance policy by its ID.
+// Corresponds to DELETE /v1/compliance/policies/{policy_id}.
+func (c *Client) DeleteCompliancePolicy(ctx context.Context, policyID string) error {
+	if strings.TrimSpace(policyID) == "" {
+		return fmt.Errorf("policyID cannot be empty")
+	}
+
+	resp, err := c.generated.ComplianceAPI.DeletePolicyV1CompliancePoliciesPolicyIdDelete(c.withAuth(ctx), policyID).Execute()
+	if err != nil {
+		return convertError(err, resp)
+	}
+	return nil
+}
+
+// ApplyCompliancePolicyToCapability applies the controls of a compliance policy to a capability.
+// Corresponds to PUT /v1/compliance/capabilities/{capability_id}/policies/{policy_id}.
+func (c *Client) ApplyCompliancePolicyToCapability(ctx context.Context, capabilityID string, policyID string) (*api.ApplyControlsResponse, error) {
+	if strings.TrimSpace(capabilityID) == "" {
+		return nil, fmt.Errorf("capabilityID cannot be empty")
+	}
+	if strings.TrimSpace(policyID) == "" {
+		return nil, fmt.Errorf("policyID cannot be empty")
+	}
+
+	result, resp, err := c.generated.ComplianceAPI.ApplyControlsV1ComplianceCapabilitiesCapabilityIdPoliciesPolicyIdPut(c.withAuth(ctx), capabilityID, policyID).Execute()
+	if err != nil {
+		return nil, convertError(err, resp)
+	}
+
+	return result, nil
+}
+
+// RemoveCompliancePolicyFromCapability removes the controls of a compliance policy from a capability.
+// Corresponds to DELETE /v1/compliance/capabilities/{capability_id}/policies/{policy_id}.
+func (c *Client) RemoveCompliancePolicyFromCapability(ctx context.Context, capabilityID string, policyID string) (*api.RemoveControlsResponse, error) {
+	if strings.TrimSpace(capabilityID) == "" {
+		return nil, fmt.Errorf("capabilityID cannot be empty")
+	}
+	if strings.TrimSpace(policyID) == "" {
+		return nil, fmt.Errorf("policyID cannot be empty")
+	}
+
+	result, resp, err := c.generated.ComplianceAPI.RemoveControlsV1ComplianceCapabilitiesCapabilityIdPoliciesPolicyIdDelete(c.withAuth(ctx), capabilityID, policyID).Execute()
+	if err != nil {
+		return nil, convertError(err, resp)
+	}
+
+	return result, nil
+}
+
+// GetApplicableCompliancePolicies retrieves the compliance policies that apply to a capability, and whether they are applied.
+// Corresponds to GET /v1/compliance/capabilities/{capability_id}/policies.
+func (c *Client) GetApplicableCompliancePolicies(ctx context.Context, capabilityID string) ([]api.ApplicablePolicyResponse, error) {
+	if strings.TrimSpace(capabilityID) == "" {
+		return nil, fmt.Errorf("capabilityID cannot be empty")
+	}
+
+	result, resp, err := c.generated.ComplianceAPI.GetApplicablePoliciesV1ComplianceCapabilitiesCapabilityIdPoliciesGet(c.withAuth(ctx), capabilityID).Execute()
+	if err != nil {
+		return nil, convertError(err, resp)
+	}
+
+	return result, nil
+}
+
+// GetCompliancePolicyImpact retrieves how many assets a compliance policy affects, and how many of those are non-compliant.
+// Corresponds to GET /v1/compliance/policies/{policy_id}/impact.
+func (c *Client) GetCompliancePolicyImpact(ctx context.Context, policyID string) (*api.PolicyImpactResponse, error) {
+	if strings.TrimSpace(policyID) == "" {
+		return nil, fmt.Errorf("policyID cannot be empty")
+	}
+
+	result, resp, err := c.generated.ComplianceAPI.GetPolicyImpactV1CompliancePoliciesPolicyIdImpactGet(c.withAuth(ctx), policyID).Execute()
+	if err != nil {
+		return nil, convertError(err, resp)
+	}
+
+	return result, nil
+}
+
+// ApplyCompliancePolicyToAll applies the controls of a compliance policy to all non-compliant capabilities in its scope.
+// The response does not list the capabilities that were changed, use ListComplianceAssets beforehand to determine them.
+// Corresponds to POST /v1/compliance/policies/{policy_id}/applications.
+func (c *Client) ApplyCompliancePolicyToAll(ctx context.Context, policyID string) (*PolicyApplicationResponse, error) {
+	if strings.TrimSpace(policyID) == "" {
+		return nil, fmt.Errorf("policyID cannot be empty")
+	}
+
+	result, resp, err := c.generated.ComplianceAPI.ApplyPolicyToAllV1CompliancePoliciesPolicyIdApplicationsPost(c.withAuth(ctx), policyID).Execute()
+	if err != nil {
+		return nil, convertError(err, resp)
+	}
+
+	raw, err := json.Marshal(result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode policy application response: %w", err)
+	}
+	application := &PolicyApplicationResponse{Raw: raw}
+	// The body is not guaranteed to be an object, in which case only the raw body is available.
+	_ = json.Unmarshal(raw, application)
+
+	return application, nil
+}
+
+// DryRunGuardrails scans sample text with guardrail controls, either inline or from a policy, without persisting anything.
+// Corresponds to POST /v1/compliance/guardrail-scans.
+func (c *Client) DryRunGuardrails(ctx context.Context, request api.DryRunRequest) (*api.DryRunResponse, error) {
+	result, resp, err := c.generated.ComplianceAPI.DryRunGuardrailsV1ComplianceGuardrailScansPost(c.withAuth(ctx)).DryRunRequest(request).Execute()
+	if err != nil {
+		return nil, convertError(err, resp)
+	}
+
+	return result, nil
+}
+
+// --- Compliance Posture Methods ---
+
+// ListComplianceAssets retrieves the compliance status of all capabilities.
+// projectID and status are optional filters, empty values are not sent.
+// Corresponds to GET /v1/compliance/assets.
+func (c *Client) ListComplianceAssets(ctx context.Context, projectID string, status string) ([]api.AssetResponse, error) {
+	request := c.generated.ComplianceAPI.ListAssetsV1ComplianceAssetsGet(c.withAuth(ctx))
+	if projectID != "" {
+		request = request.ProjectId(projectID)
+	}
+	if status != "" {
+		request = request.Status(api.ComplianceStatus(status))
+	}
+
+	result, resp, err := request.Execute()
+	if err != nil {
+		return nil, convertError(err, resp)
+	}
+
+	return result, nil
+}
+
+// GetComplianceAsset retrieves the compliance status of a capability, including the result per policy.
+// Corresponds to GET /v1/compliance/assets/{capability_id}.
+func (c *Client) GetComplianceAsset(ctx context.Context, capabilityID string) (*api.AssetResponse, error) {
+	if strings.TrimSpace(capabilityID) == "" {
+		return nil, fmt.Errorf("capabilityID cannot be empty")
+	}
+
+	result, resp, err := c.generated.ComplianceAPI.GetAssetDetailV1ComplianceAssetsCapabilityIdGet(c.withAuth(ctx), capabilityID).Execute()
+	if err != nil {
+		return nil, convertError(err, resp)
+	}
+
+	return result, nil
+}
+
+// GetComplianceBadge retrieves the compliance badge of a capability.
+// Corresponds to GET /v1/compliance/assets/{capability_id}/badge.
+func (c *Client) GetComplianceBadge(ctx context.Context, capabilityID string) (*api.BadgeResponse, error) {
+	if strings.TrimSpace(capabilityID) == "" {
+		return nil, fmt.Errorf("capabilityID cannot be empty")
+	}
+
+	result, resp, err := c.generated.ComplianceAPI.GetComplianceBadgeV1ComplianceAssetsCapabilityIdBadgeGet(c.withAuth(ctx), capabilityID).Execute()
+	if err != nil {
+		return nil, convertError(err, resp)
+	}
+
+	return result, nil
+}
+
+// ListGuardrails retrieves the guardrail settings of all capabilities as a matrix.
+// projectID, sortBy and sortOrder are optional, empty values use the API defaults.
+// Corresponds to GET /v1/compliance/guardrails.
+func (c *Client) ListGuardrails(ctx context.Context, projectID string, sortBy string, sortOrder string) ([]api.GuardrailRow, error) {
+	request := c.generated.ComplianceAPI.GetGuardrailsV1ComplianceGuardrailsGet(c.withAuth(ctx))
+	if projectID != "" {
+		request = request.ProjectId(projectID)
+	}
+	if sortBy != "" {
+		request = request.SortBy(sortBy)
+	}
+	if sortOrder != "" {
+		request = request.SortOrder(sortOrder)
+	}
+
+	result, resp, err := request.Execute()
+	if err != nil {
+		return nil, convertError(err, resp)
+	}
+
+	return result, nil
+}
+
+// ListGuardrailEvents retrieves the guardrail events matching the filter, newest first, walking the
+// limit/offset pages until all events are read or maxEvents is reached. A maxEvents of 0 reads all events.
+// The total number of matching events reported by the API is returned as well.
+// Corresponds to GET /v1/compliance/guardrail-events.
+func (c *Client) ListGuardrailEvents(ctx context.Context, filter GuardrailEventFilter, maxEvents int) ([]api.GuardrailEventResponse, int, error) {
+	var events []api.GuardrailEventResponse
+	total := 0
+	for offset := 0; maxEvents == 0 || len(events) < maxEvents; {
+		limit := guardrailEventsPageSize
+		if maxEvents > 0 && maxEvents-len(events) < limit {
+			limit = maxEvents - len(events)
+		}
+
+		request := c.generated.ComplianceAPI.ListGuardrailEventsV1ComplianceGuardrailEventsGet(c.withAuth(ctx)).
+			Limit(int32(limit)).Offset(int32(offset))
+		if filter.CapabilityID != "" {
+			request = request.CapabilityId(filter.CapabilityID)
+		}
+		if filter.PolicyID != "" {
+			request = request.PolicyId(filter.PolicyID)
+		}
+		if filter.ControlType != "" {
+			request = request.ControlType(api.ScanCategory(filter.ControlType))
+		}
+		if filter.Direction != "" {
+			request = request.Direction(api.ScanDirection(filter.Direction))
+		}
+		if filter.FromDate != nil {
+			request = request.FromDate(*filter.FromDate)
+		}
+		if filter.ToDate != nil {
+			request = request.ToDate(*filter.ToDate)
+		}
+
+		result, resp, err := request.Execute()
+		if err != nil {
+			return nil, 0, convertError(err, resp)
+		}
+
+		events = append(events, result.Events...)
+		total = int(result.Total)
+		offset += len(result.Events)
+		if len(result.Events) == 0 || offset >= total {
+			break
+		}
+	}
+
+	return events, total, nil
+}
+
+// --- RBAC Methods ---
+
+// ListPermissions lists every permission that can be granted to a role.
+// Corresponds to GET /v1/admin/permissions.
+func (c *Client) ListPermissions(ctx context.Context) ([]api.PermissionItem, error) {
+	result, resp, err := c.generated.RBACAdminAPI.ListPermissionsV1AdminPermissionsGet(c.withAuth(ctx)).Execute()
+	if err != nil {
+		return nil, convertError(err, resp)
+	}
+	return result, nil
+}
+
+// ListRoles lists all roles, reading every page.
+// Corresponds to GET /v1/admin/roles.
+func (c *Client) ListRoles(ctx context.Context) ([]api.Role, error) {
+	return listAllPages(func(page int32, size int32) ([]api.Role, int32, error) {
+		result, resp, err := c.generated.RBACAdminAPI.ListRolesV1AdminRolesGet(c.withAuth(ctx)).
+			Page(page).
+			Size(size).
+			Execute()
+
+		if err != nil {
+			return nil, 0, convertError(err, resp)
+		}
+		return result.GetEmbedded(), result.Page.TotalPages, nil
+	})
+}
+
+// GetRole retrieves a role by its ID. The API has no endpoint for a single role,
+// so the role is looked up in the role listing.
+// Corresponds to GET /v1/admin/roles.
+func (c *Client) GetRole(ctx context.Context, roleID string) (*api.Role, error) {
+	if strings.TrimSpace(roleID) == "" {
+		return nil, fmt.Errorf("roleID cannot be empty")
+	}
+
+	roles, err := c.ListRoles(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range roles {
+		if roles[i].Id == roleID {
+			return &roles[i], nil
+		}
+	}
+
+	return nil, ErrNotFound
+}
+
+// CreateRole creates a new role.
+// Corresponds to POST /v1/admin/roles.
+func (c *Client) CreateRole(ctx context.Context, role api.RoleCreate) (*api.Role, error) {
+	result, resp, err := c.generated.RBACAdminAPI.CreateRoleV1AdminRolesPost(c.withAuth(ctx)).
+		RoleCreate(role).
+		Execute()
+
+	if err != nil {
+		return nil, convertError(err, resp)
+	}
+
+	return result, nil
+}
+
+// UpdateRole replaces the name, description and permissions of a role.
+// Corresponds to PUT /v1/admin/roles/{role_id}.
+func (c *Client) UpdateRole(ctx context.Context, roleID string, role api.RoleUpdate) (*api.Role, error) {
+	if strings.TrimSpace(roleID) == "" {
+		return nil, fmt.Errorf("roleID cannot be empty")
+	}
+
+	result, resp, err := c.generated.RBACAdminAPI.UpdateRoleV1AdminRolesRoleIdPut(c.withAuth(ctx), roleID).
+		RoleUpdate(role).
+		Execute()
+
+	if err != nil {
+		return nil, convertError(err, resp)
+	}
+
+	return result, nil
+}
+
+// DeleteRole deletes a role.
+// Corresponds to DELETE /v1/admin/roles/{role_id}.
+func (c *Client) DeleteRole(ctx context.Context, roleID string) error {
+	if strings.TrimSpace(roleID) == "" {
+		return fmt.Errorf("roleID cannot be empty")
+	}
+
+	resp, err := c.generated.RBACAdminAPI.DeleteRoleV1AdminRolesRoleIdDelete(c.withAuth(ctx), roleID).Execute()
+	if err != nil {
+		return convertError(err, resp)
+	}
+	return nil
+}
+
+// ListUsers lists all users with their role, reading every page.
+// Corresponds to GET /v1/admin/users.
+func (c *Client) ListUsers(ctx context.Context) ([]api.UserRBAC, error) {
+	return listAllPages(func(page int32, size int32) ([]api.UserRBAC, int32, error) {
+		result, resp, err := c.generated.RBACAdminAPI.ListUsersV1AdminUsersGet(c.withAuth(ctx)).
+			Page(page).
+			Size(size).
+			Execute()
+
+		if err != nil {
+			return nil, 0, convertError(err, resp)
+		}
+		return result.GetEmbedded(), result.Page.TotalPages, nil
+	})
+}
+
+// GetUser retrieves a user by its ID. The API has no endpoint for a single user,
+// so the user is looked up in the user listing.
+// Corresponds to GET /v1/admin/users.
+func (c *Client) GetUser(ctx context.Context, userID string) (*api.UserRBAC, error) {
+	if strings.TrimSpace(userID) == "" {
+		return nil, fmt.Errorf("userID cannot be empty")
+	}
+
+	users, err := c.ListUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range users {
+		if users[i].Id == userID {
+			return &users[i], nil
+		}
+	}
+
+	return nil, ErrNotFound
+}
+
+// UpdateUser changes the role and/or active flag of a user.
+// Corresponds to PATCH /v1/admin/users/{user_id}.
+func (c *Client) UpdateUser(ctx context.Context, userID string, user api.UserUpdate) (*api.UserRBAC, error) {
+	if strings.TrimSpace(userID) == "" {
+		return nil, fmt.Errorf("userID cannot be empty")
+	}
+
+	result, resp, err := c.generated.RBACAdminAPI.UpdateUserV1AdminUsersUserIdPatch(c.withAuth(ctx), userID).
+		UserUpdate(user).
+		Execute()
+
+	if err != nil {
+		return nil, convertError(err, resp)
+	}
+
+	return result, nil
+}
+
+// --- Auth Methods ---
+
+// GetCurrentIdentity returns the user or API key the client is authenticated as, with its permissions.
+// Corresponds to GET /v1/auth/me.
+func (c *Client) GetCurrentIdentity(ctx context.Context) (*api.AuthMeResponse, error) {
+	result, resp, err := c.generated.AuthAPI.GetMeV1AuthMeGet(c.withAuth(ctx)).Execute()
+	if err != nil {
+		return nil, convertError(err, resp)
+	}
+	return result, nil
+}
+
+// --- Preflight Methods ---
+
+// Feature flags reported by GET /features that resources check before using a feature.
+const (
+	FeatureCompliance = "compliance"
+	FeatureMCPServers = "mcp_servers"
+)
+
+// Healthcheck checks that the API is reachable and healthy.
+// Corresponds to GET /.
+func (c *Client) Healthcheck(ctx context.Context) error {
+	_, resp, err := c.generated.DefaultAPI.HealthcheckGet(ctx).Execute()
+	if err != nil {
+		return convertError(err, resp)
+	}
+	return nil
+}
+
+// GetFeatures returns the feature flags enabled on the server.
+// Corresponds to GET /features.
+func (c *Client) GetFeatures(ctx context.Context) (map[string]bool, error) {
+	result, resp, err := c.generated.DefaultAPI.GetFeaturesFeaturesGet(c.withAuth(ctx)).Execute()
+	if err != nil {
+		return nil, convertError(err, resp)
+	}
+	return result, nil
+}
+
+// Preflight checks that the API is reachable and accepts the API key, then discovers and stores
+// the server's feature flags. The returned error names the check that failed.
+func (c *Client) Preflight(ctx context.Context) (*api.AuthMeResponse, error) {
+	if err := c.Healthcheck(ctx); err != nil {
+		return nil, fmt.Errorf("healthcheck failed: %w", err)
+	}
+
+	me, err := c.GetCurrentIdentity(ctx)
+	if err != nil {
+		var apiErr *APIError
+		if errors.As(err, &apiErr) && (apiErr.StatusCode == http.StatusUnauthorized || apiErr.StatusCode == http.StatusForbidden) {
+			return nil, fmt.Errorf("the API key was rejected: %w", err)
+		}
+		return nil, fmt.Errorf("unable to read the authenticated identity: %w", err)
+	}
+
+	features, err := c.GetFeatures(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to discover feature flags: %w", err)
+	}
+	c.features = features
+
+	return me, nil
+}
+
+// Features returns the feature flags discovered by Preflight, or nil when they were not discovered.
+func (c *Client) Features() map[string]bool {
+	return c.features
+}
+
+// FeatureDisabled reports whether the server explicitly reported the feature as disabled.
+// Features are assumed enabled when they were not discovered or not reported.
+func (c *Client) FeatureDisabled(feature string) bool {
+	enabled, ok := c.features[feature]
+	return ok && !enabled
+}
@@ -6,9 +6,15 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	api "terraform-provider-corax/internal/generated"
 )
@@ -494,83 +500,111 @@ func TestDeleteProject(t *testing.T) {
 	})
 }
 
-// TestCreateModelDeployment tests the CreateModelDeployment method.
-func TestCreateModelDeployment(t *testing.T) {
+// TestCreateCollection tests the CreateCollection method.
+func TestCreateCollection(t *testing.T) {
 	t.Run("successful creation", func(t *testing.T) {
 		handler := func(w http.ResponseWriter, r *http.Request) {
 			if r.Method != http.MethodPost {
 				t.Errorf("Expected POST, got %s", r.Method)
 			}
-			if r.URL.Path != "/v1/model-deployments" {
-				t.Errorf("Expected /v1/model-deployments, got %s", r.URL.Path)
+			if r.URL.Path != "/v1/collections" {
+				t.Errorf("Expected /v1/collections, got %s", r.URL.Path)
+			}
+
+			var reqBody CollectionCreate
+			if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+				t.Errorf("Failed to decode request body: %v", err)
+			}
+			if reqBody.Name != "test-collection" {
+				t.Errorf("Expected name 'test-collection', got %s", reqBody.Name)
+			}
+			if reqBody.ProjectID == nil || *reqBody.ProjectID != "proj-123" {
+				t.Errorf("Expected project_id 'proj-123', got %v", reqBody.ProjectID)
 			}
 
 			w.Header().Set("Content-Type", "application/json")
 			w.WriteHeader(http.StatusOK)
-			_ = json.NewEncoder(w).Encode(ModelDeployment{
-				ID:             "deploy-123",
-				Name:           "test-deployment",
-				ProviderID:     "prov-123",
-				SupportedTasks: []string{"chat"},
-				Configuration:  map[string]string{"model": "gpt-4"},
-				CreatedBy:      "user-1",
-				CreatedAt:      "2024-01-01T00:00:00Z",
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"id":              "col-123",
+				"name":            "test-collection",
+				"description":     "RAG sources",
+				"is_public":       false,
+				"project_id":      "proj-123",
+				"created_by":      "user-1",
+				"created_at":      "2024-01-01T00:00:00Z",
+				"updated_by":      "user-1",
+				"updated_at":      "2024-01-01T00:00:00Z",
+				"owner":           "user-1",
+				"documents_count": 0,
 			})
 		}
 
 		server, client := setupTestServer(t, handler)
 		defer server.Close()
 
-		result, err := client.CreateModelDeployment(context.Background(), ModelDeploymentCreate{
-			Name:           "test-deployment",
-			ProviderID:     "prov-123",
-			SupportedTasks: []string{"chat"},
-			Configuration:  map[string]string{"model": "gpt-4"},
+		description := "RAG sources"
+		projectID := "proj-123"
+		result, err := client.CreateCollection(context.Background(), CollectionCreate{
+			Name:        "test-collection",
+			Description: &description,
+			ProjectID:   &projectID,
 		})
 
 		if err != nil {
 			t.Fatalf("Unexpected error: %v", err)
 		}
-		if result.ID != "deploy-123" {
-			t.Errorf("Expected ID 'deploy-123', got %s", result.ID)
+		if result.ID != "col-123" {
+			t.Errorf("Expected ID 'col-123', got %s", result.ID)
+		}
+		if result.ProjectID == nil || *result.ProjectID != "proj-123" {
+			t.Errorf("Expected project_id 'proj-123', got %v", result.ProjectID)
 		}
 	})
 }
 
-// TestGetModelDeployment tests the GetModelDeployment method.
-func TestGetModelDeployment(t *testing.T) {
+// TestGetCollection tests the GetCollection method.
+func TestGetCollection(t *testing.T) {
 	t.Run("successful get", func(t *testing.T) {
 		handler := func(w http.ResponseWriter, r *http.Request) {
 			if r.Method != http.MethodGet {
 				t.Errorf("Expected GET, got %s", r.Method)
 			}
-			if r.URL.Path != "/v1/model-deployments/deploy-123" {
-				t.Errorf("Expected /v1/model-deployments/deploy-123, got %s", r.URL.Path)
+			if r.URL.Path != "/v1/collections/col-123" {
+				t.Errorf("Expected /v1/collections/col-123, got %s", r.URL.Path)
 			}
 
 			w.Header().Set("Content-Type", "application/json")
 			w.WriteHeader(http.StatusOK)
-			_ = json.NewEncoder(w).Encode(ModelDeployment{
-				ID:             "deploy-123",
-				Name:           "test-deployment",
-				ProviderID:     "prov-123",
-				SupportedTasks: []string{"chat"},
-				Configuration:  map[string]string{"model": "gpt-4"},
-				CreatedBy:      "user-1",
-				CreatedAt:      "2024-01-01T00:00:00Z",
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"id":              "col-123",
+				"name":            "test-collection",
+				"is_public":       true,
+				"project_id":      nil,
+				"created_by":      "user-1",
+				"created_at":      "2024-01-01T00:00:00Z",
+				"updated_by":      "user-1",
+				"updated_at":      "2024-01-01T00:00:00Z",
+				"owner":           "user-1",
+				"documents_count": 3,
 			})
 		}
 
 		server, client := setupTestServer(t, handler)
 		defer server.Close()
 
-		result, err := client.GetModelDeployment(context.Background(), "deploy-123")
+		result, err := client.GetCollection(context.Background(), "col-123")
 
 		if err != nil {
 			t.Fatalf("Unexpected error: %v", err)
 		}
-		if result.ID != "deploy-123" {
-			t.Errorf("Expected ID 'deploy-123', got %s", result.ID)
+		if result.ID != "col-123" {
+			t.Errorf("Expected ID 'col-123', got %s", result.ID)
+		}
+		if result.DocumentsCount != 3 {
+			t.Errorf("Expected documents_count 3, got %d", result.DocumentsCount)
+		}
+		if result.ProjectID != nil {
+			t.Errorf("Expected nil project_id, got %v", *result.ProjectID)
 		}
 	})
 
@@ -582,7 +616,7 @@ func TestGetModelDeployment(t *testing.T) {
 		server, client := setupTestServer(t, handler)
 		defer server.Close()
 
-		_, err := client.GetModelDeployment(context.Background(), "nonexistent")
+		_, err := client.GetCollection(context.Background(), "nonexistent")
 
 		if err == nil {
 			t.Fatal("Expected error but got nil")
@@ -591,297 +625,274 @@ func TestGetModelDeployment(t *testing.T) {
 			t.Errorf("Expected ErrNotFound, got %v", err)
 		}
 	})
+
+	t.Run("empty collection ID", func(t *testing.T) {
+		_, client := setupTestServer(t, nil)
+
+		_, err := client.GetCollection(context.Background(), "")
+
+		if err == nil {
+			t.Fatal("Expected error but got nil")
+		}
+	})
 }
 
-// TestCreateModelProvider tests the CreateModelProvider method.
-func TestCreateModelProvider(t *testing.T) {
-	t.Run("successful creation", func(t *testing.T) {
+// TestUpdateCollection tests the UpdateCollection method.
+func TestUpdateCollection(t *testing.T) {
+	t.Run("successful update", func(t *testing.T) {
 		handler := func(w http.ResponseWriter, r *http.Request) {
-			if r.Method != http.MethodPost {
-				t.Errorf("Expected POST, got %s", r.Method)
+			if r.Method != http.MethodPut {
+				t.Errorf("Expected PUT, got %s", r.Method)
 			}
-			if r.URL.Path != "/v1/model-providers" {
-				t.Errorf("Expected /v1/model-providers, got %s", r.URL.Path)
+			if r.URL.Path != "/v1/collections/col-123" {
+				t.Errorf("Expected /v1/collections/col-123, got %s", r.URL.Path)
+			}
+
+			var reqBody CollectionUpdate
+			if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+				t.Errorf("Failed to decode request body: %v", err)
 			}
 
 			w.Header().Set("Content-Type", "application/json")
 			w.WriteHeader(http.StatusOK)
-			_ = json.NewEncoder(w).Encode(ModelProvider{
-				ID:            "prov-123",
-				Name:          "test-provider",
-				ProviderType:  "openai",
-				Configuration: map[string]string{"api_key": "secret"},
-				CreatedBy:     "user-1",
-				CreatedAt:     "2024-01-01T00:00:00Z",
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"id":              "col-123",
+				"name":            reqBody.Name,
+				"is_public":       reqBody.IsPublic,
+				"created_by":      "user-1",
+				"created_at":      "2024-01-01T00:00:00Z",
+				"updated_by":      "user-1",
+				"updated_at":      "2024-01-02T00:00:00Z",
+				"owner":           "user-1",
+				"documents_count": 0,
 			})
 		}
 
 		server, client := setupTestServer(t, handler)
 		defer server.Close()
 
-		result, err := client.CreateModelProvider(context.Background(), ModelProviderCreate{
-			Name:          "test-provider",
-			ProviderType:  "openai",
-			Configuration: map[string]string{"api_key": "secret"},
+		result, err := client.UpdateCollection(context.Background(), "col-123", CollectionUpdate{
+			Name:     "updated-collection",
+			IsPublic: true,
 		})
 
 		if err != nil {
 			t.Fatalf("Unexpected error: %v", err)
 		}
-		if result.ID != "prov-123" {
-			t.Errorf("Expected ID 'prov-123', got %s", result.ID)
+		if result.Name != "updated-collection" {
+			t.Errorf("Expected name 'updated-collection', got %s", result.Name)
+		}
+		if !result.IsPublic {
+			t.Error("Expected is_public to be true")
 		}
 	})
 }
 
-// TestGetModelProvider tests the GetModelProvider method.
-func TestGetModelProvider(t *testing.T) {
-	t.Run("successful get", func(t *testing.T) {
+// TestDeleteCollection tests the DeleteCollection method.
+func TestDeleteCollection(t *testing.T) {
+	t.Run("successful delete", func(t *testing.T) {
 		handler := func(w http.ResponseWriter, r *http.Request) {
-			if r.Method != http.MethodGet {
-				t.Errorf("Expected GET, got %s", r.Method)
+			if r.Method != http.MethodDelete {
+				t.Errorf("Expected DELETE, got %s", r.Method)
 			}
-			if r.URL.Path != "/v1/model-providers/prov-123" {
-				t.Errorf("Expected /v1/model-providers/prov-123, got %s", r.URL.Path)
+			if r.URL.Path != "/v1/collections/col-123" {
+				t.Errorf("Expected /v1/collections/col-123, got %s", r.URL.Path)
 			}
 
-			w.Header().Set("Content-Type", "application/json")
-			w.WriteHeader(http.StatusOK)
-			_ = json.NewEncoder(w).Encode(ModelProvider{
-				ID:            "prov-123",
-				Name:          "test-provider",
-				ProviderType:  "openai",
-				Configuration: map[string]string{"api_key": "secret"},
-				CreatedBy:     "user-1",
-				CreatedAt:     "2024-01-01T00:00:00Z",
-			})
+			w.WriteHeader(http.StatusNoContent)
 		}
 
 		server, client := setupTestServer(t, handler)
 		defer server.Close()
 
-		result, err := client.GetModelProvider(context.Background(), "prov-123")
+		err := client.DeleteCollection(context.Background(), "col-123")
 
 		if err != nil {
 			t.Fatalf("Unexpected error: %v", err)
 		}
-		if result.ID != "prov-123" {
-			t.Errorf("Expected ID 'prov-123', got %s", result.ID)
-		}
-	})
-}
-
-// TestAPIErrorIs tests the errors.Is functionality for APIError.
-func TestAPIErrorIs(t *testing.T) {
-	t.Run("is ErrNotFound", func(t *testing.T) {
-		err := &APIError{StatusCode: http.StatusNotFound, Message: "not found"}
-		if !errors.Is(err, ErrNotFound) {
-			t.Error("Expected error to match ErrNotFound")
-		}
-	})
-
-	t.Run("is not ErrNotFound", func(t *testing.T) {
-		err := &APIError{StatusCode: http.StatusBadRequest, Message: "bad request"}
-		if errors.Is(err, ErrNotFound) {
-			t.Error("Expected error to not match ErrNotFound")
-		}
 	})
 }
 
-// TestCreateCompletionCapability verifies that a completion-capability create
-// response is deserialized into *CompletionCapability rather than being
-// misclassified as a ChatCapability. The four capability schemas share most
-// fields, so the generator's default anyOf "try each in order" logic picks
-// ChatCapability for any response unless we use the type discriminator.
-func TestCreateCompletionCapability(t *testing.T) {
-	t.Run("response with type=completion deserializes as CompletionCapability", func(t *testing.T) {
+// TestEmbedDocument tests the EmbedDocument method.
+func TestEmbedDocument(t *testing.T) {
+	t.Run("successful upload", func(t *testing.T) {
 		handler := func(w http.ResponseWriter, r *http.Request) {
 			if r.Method != http.MethodPost {
 				t.Errorf("Expected POST, got %s", r.Method)
 			}
-			if r.URL.Path != "/v1/capabilities" {
-				t.Errorf("Expected /v1/capabilities, got %s", r.URL.Path)
+			if r.URL.Path != "/v1/collections/col-123/embed" {
+				t.Errorf("Expected /v1/collections/col-123/embed, got %s", r.URL.Path)
+			}
+			if r.Header.Get("X-API-Key") != "test-api-key" {
+				t.Errorf("Expected X-API-Key header 'test-api-key', got %s", r.Header.Get("X-API-Key"))
+			}
+
+			file, header, err := r.FormFile("files")
+			if err != nil {
+				t.Fatalf("Expected multipart file part 'files': %v", err)
+			}
+			defer file.Close()
+			if header.Filename != "guide.md" {
+				t.Errorf("Expected filename 'guide.md', got %s", header.Filename)
+			}
+			content, _ := io.ReadAll(file)
+			if string(content) != "# Guide" {
+				t.Errorf("Expected file content '# Guide', got %q", string(content))
+			}
+			if r.FormValue("language") != "en" {
+				t.Errorf("Expected language 'en', got %s", r.FormValue("language"))
+			}
+			if r.FormValue("document_metadata") != `{"team":"docs"}` {
+				t.Errorf("Expected document_metadata JSON, got %s", r.FormValue("document_metadata"))
 			}
 
 			w.Header().Set("Content-Type", "application/json")
-			w.WriteHeader(http.StatusCreated)
+			w.WriteHeader(http.StatusOK)
 			_ = json.NewEncoder(w).Encode(map[string]interface{}{
-				"id":                "cap-123",
-				"name":              "Event Analysis",
-				"type":              "completion",
-				"owner":             "user-1",
-				"is_public":         true,
-				"semantic_id":       "event-analysis",
-				"created_by":        "user-1",
-				"updated_by":        "user-1",
-				"created_at":        "2024-01-15T10:30:00Z",
-				"updated_at":        "2024-01-15T10:30:00Z",
-				"system_prompt":     "you are a helpful assistant",
-				"completion_prompt": "summarize: {events}",
-				"output_type":       "text",
-				"variables":         []string{"events"},
+				"collection_id": "col-123",
+				"document_ids":  []string{"doc-123"},
+				"status":        "completed",
 			})
 		}
 
 		server, client := setupTestServer(t, handler)
 		defer server.Close()
 
-		create := api.CompletionCapabilityCreate{
-			Name:             "Event Analysis",
-			Type:             "completion",
-			SystemPrompt:     "you are a helpful assistant",
-			CompletionPrompt: "summarize: {events}",
-			OutputType:       "text",
-		}
+		language := "en"
+		result, err := client.EmbedDocument(context.Background(), "col-123", DocumentUpload{
+			Filename:         "guide.md",
+			Content:          []byte("# Guide"),
+			Language:         &language,
+			DocumentMetadata: map[string]string{"team": "docs"},
+		})
 
-		result, err := client.CreateCompletionCapability(context.Background(), create)
 		if err != nil {
 			t.Fatalf("Unexpected error: %v", err)
 		}
-		if result == nil {
-			t.Fatal("Expected non-nil result")
-		}
-		if result.Id != "cap-123" {
-			t.Errorf("Expected ID 'cap-123', got %s", result.Id)
-		}
-		if result.CompletionPrompt != "summarize: {events}" {
-			t.Errorf("Expected completion_prompt to round-trip, got %q", result.CompletionPrompt)
-		}
-		if result.OutputType != "text" {
-			t.Errorf("Expected output_type 'text', got %q", result.OutputType)
+		if len(result.DocumentIds) != 1 || result.DocumentIds[0] != "doc-123" {
+			t.Errorf("Expected document_ids [doc-123], got %v", result.DocumentIds)
 		}
 	})
-}
 
-// TestCreateChatCapability verifies a chat-capability create response is
-// deserialized as *ChatCapability via the type discriminator.
-func TestCreateChatCapability(t *testing.T) {
-	t.Run("response with type=chat deserializes as ChatCapability", func(t *testing.T) {
+	t.Run("collection not found", func(t *testing.T) {
 		handler := func(w http.ResponseWriter, r *http.Request) {
-			w.Header().Set("Content-Type", "application/json")
-			w.WriteHeader(http.StatusCreated)
-			_ = json.NewEncoder(w).Encode(map[string]interface{}{
-				"id":             "cap-456",
-				"name":           "Corax Data",
-				"type":           "chat",
-				"owner":          "user-1",
-				"is_public":      true,
-				"semantic_id":    "corax-data-chatbot",
-				"created_by":     "user-1",
-				"updated_by":     "user-1",
-				"created_at":     "2024-01-15T10:30:00Z",
-				"updated_at":     "2024-01-15T10:30:00Z",
-				"system_prompt":  "you are corax",
-				"collection_ids": []string{"col-1", "col-2"},
-			})
+			w.WriteHeader(http.StatusNotFound)
 		}
 
 		server, client := setupTestServer(t, handler)
 		defer server.Close()
 
-		create := api.ChatCapabilityCreate{
-			Name:         "Corax Data",
-			Type:         "chat",
-			SystemPrompt: "you are corax",
-		}
+		_, err := client.EmbedDocument(context.Background(), "nonexistent", DocumentUpload{Filename: "a.txt"})
 
-		result, err := client.CreateChatCapability(context.Background(), create)
-		if err != nil {
-			t.Fatalf("Unexpected error: %v", err)
-		}
-		if result == nil {
-			t.Fatal("Expected non-nil result")
-		}
-		if result.Id != "cap-456" {
-			t.Errorf("Expected ID 'cap-456', got %s", result.Id)
+		if !errors.Is(err, ErrNotFound) {
+			t.Errorf("Expected ErrNotFound, got %v", err)
 		}
-		if len(result.CollectionIds) != 2 {
-			t.Errorf("Expected 2 collection_ids, got %d", len(result.CollectionIds))
+	})
+
+	t.Run("empty collection ID", func(t *testing.T) {
+		_, client := setupTestServer(t, nil)
+
+		_, err := client.EmbedDocument(context.Background(), "", DocumentUpload{Filename: "a.txt"})
+
+		if err == nil {
+			t.Fatal("Expected error but got nil")
 		}
 	})
 }
 
-// TestGetCapability tests the GetCapability method.
-func TestGetCapability(t *testing.T) {
+// TestGetDocument tests the GetDocument method.
+func TestGetDocument(t *testing.T) {
 	t.Run("successful get", func(t *testing.T) {
 		handler := func(w http.ResponseWriter, r *http.Request) {
-			if r.Method != http.MethodGet {
-				t.Errorf("Expected GET, got %s", r.Method)
+			if r.URL.Path != "/v1/collections/col-123/documents" {
+				t.Errorf("Expected /v1/collections/col-123/documents, got %s", r.URL.Path)
 			}
-			if r.URL.Path != "/v1/capabilities/cap-123" {
-				t.Errorf("Expected /v1/capabilities/cap-123, got %s", r.URL.Path)
+			if r.URL.Query().Get("filter") != "id::doc-123" {
+				t.Errorf("Expected filter 'id::doc-123', got %s", r.URL.Query().Get("filter"))
 			}
 
 			w.Header().Set("Content-Type", "application/json")
 			w.WriteHeader(http.StatusOK)
 			_ = json.NewEncoder(w).Encode(map[string]interface{}{
-				"id":                 "cap-123",
-				"name":               "test-capability",
-				"type":               "chat",
-				"owner":              "user-1",
-				"is_public":          false,
-				"semantic_id":        "my-chat-capability",
-				"created_by":         "user-1",
-				"updated_by":         "user-1",
-				"created_at":         "2024-01-15T10:30:00Z",
-				"updated_at":         "2024-01-15T10:30:00Z",
-				"input":              map[string]interface{}{},
-				"output":             map[string]interface{}{},
-				"configuration":      map[string]interface{}{},
-				"is_default_version": true,
+				"_embedded": []map[string]interface{}{
+					{
+						"id":            "doc-123",
+						"collection_id": "col-123",
+						"filename":      "guide.md",
+						"checksum":      "abc123",
+						"version":       2,
+						"date_added":    "2024-01-01T00:00:00Z",
+						"last_updated":  "2024-01-02T00:00:00Z",
+					},
+				},
+				"page": map[string]interface{}{"number": 1, "size": 100, "total_elements": 1, "total_pages": 1},
 			})
 		}
 
 		server, client := setupTestServer(t, handler)
 		defer server.Close()
 
-		result, err := client.GetCapability(context.Background(), "cap-123")
+		result, err := client.GetDocument(context.Background(), "col-123", "doc-123")
 
 		if err != nil {
 			t.Fatalf("Unexpected error: %v", err)
 		}
-		if result.Id != "cap-123" {
-			t.Errorf("Expected ID 'cap-123', got %s", result.Id)
+		if result.GetChecksum() != "abc123" {
+			t.Errorf("Expected checksum 'abc123', got %s", result.GetChecksum())
+		}
+		if result.GetVersion() != 2 {
+			t.Errorf("Expected version 2, got %d", result.GetVersion())
 		}
 	})
 
-	t.Run("not found", func(t *testing.T) {
+	t.Run("not in list", func(t *testing.T) {
 		handler := func(w http.ResponseWriter, r *http.Request) {
-			w.WriteHeader(http.StatusNotFound)
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"_embedded": []map[string]interface{}{},
+				"page":      map[string]interface{}{"number": 1, "size": 100, "total_elements": 0, "total_pages": 0},
+			})
 		}
 
 		server, client := setupTestServer(t, handler)
 		defer server.Close()
 
-		_, err := client.GetCapability(context.Background(), "nonexistent")
+		_, err := client.GetDocument(context.Background(), "col-123", "nonexistent")
 
-		if err == nil {
-			t.Fatal("Expected error but got nil")
-		}
 		if !errors.Is(err, ErrNotFound) {
 			t.Errorf("Expected ErrNotFound, got %v", err)
 		}
 	})
+
+	t.Run("empty document ID", func(t *testing.T) {
+		_, client := setupTestServer(t, nil)
+
+		_, err := client.GetDocument(context.Background(), "col-123", "")
+
+		if err == nil {
+			t.Fatal("Expected error but got nil")
+		}
+	})
 }
 
-// TestDeleteCapability tests the DeleteCapability method.
-func TestDeleteCapability(t *testing.T) {
-	t.Run("successful delete", func(t *testing.T) {
+// TestDeleteDocument tests the DeleteDocument method.
+func TestDeleteDocument(t *testing.T) {
+	t.Run("successful deletion", func(t *testing.T) {
 		handler := func(w http.ResponseWriter, r *http.Request) {
 			if r.Method != http.MethodDelete {
 				t.Errorf("Expected DELETE, got %s", r.Method)
 			}
-			if r.URL.Path != "/v1/capabilities/cap-123" {
-				t.Errorf("Expected /v1/capabilities/cap-123, got %s", r.URL.Path)
+			if r.URL.Path != "/v1/collections/col-123/documents/doc-123" {
+				t.Errorf("Expected /v1/collections/col-123/documents/doc-123, got %s", r.URL.Path)
 			}
-
 			w.WriteHeader(http.StatusNoContent)
 		}
 
 		server, client := setupTestServer(t, handler)
 		defer server.Close()
 
-		err := client.DeleteCapability(context.Background(), "cap-123")
+		err := client.DeleteDocument(context.Background(), "col-123", "doc-123")
 
 		if err != nil {
 			t.Fatalf("Unexpected error: %v", err)
@@ -889,70 +900,2076 @@ func TestDeleteCapability(t *testing.T) {
 	})
 }
 
-// TestListCapabilityTypes tests the ListCapabilityTypes method.
-func TestListCapabilityTypes(t *testing.T) {
-	t.Run("successful list", func(t *testing.T) {
+// TestCreateModelDeployment tests the CreateModelDeployment method.
+func TestCreateModelDeployment(t *testing.T) {
+	t.Run("successful creation", func(t *testing.T) {
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPost {
+				t.Errorf("Expected POST, got %s", r.Method)
+			}
+			if r.URL.Path != "/v1/model-deployments" {
+				t.Errorf("Expected /v1/model-deployments, got %s", r.URL.Path)
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_ = json.NewEncoder(w).Encode(ModelDeployment{
+				ID:             "deploy-123",
+				Name:           "test-deployment",
+				ProviderID:     "prov-123",
+				SupportedTasks: []string{"chat"},
+				Configuration:  map[string]string{"model": "gpt-4"},
+				CreatedBy:      "user-1",
+				CreatedAt:      "2024-01-01T00:00:00Z",
+			})
+		}
+
+		server, client := setupTestServer(t, handler)
+		defer server.Close()
+
+		result, err := client.CreateModelDeployment(context.Background(), ModelDeploymentCreate{
+			Name:           "test-deployment",
+			ProviderID:     "prov-123",
+			SupportedTasks: []string{"chat"},
+			Configuration:  map[string]string{"model": "gpt-4"},
+		})
+
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if result.ID != "deploy-123" {
+			t.Errorf("Expected ID 'deploy-123', got %s", result.ID)
+		}
+	})
+}
+
+// TestGetModelDeployment tests the GetModelDeployment method.
+func TestGetModelDeployment(t *testing.T) {
+	t.Run("successful get", func(t *testing.T) {
 		handler := func(w http.ResponseWriter, r *http.Request) {
 			if r.Method != http.MethodGet {
 				t.Errorf("Expected GET, got %s", r.Method)
 			}
-			if r.URL.Path != "/v1/capability-types" {
-				t.Errorf("Expected /v1/capability-types, got %s", r.URL.Path)
+			if r.URL.Path != "/v1/model-deployments/deploy-123" {
+				t.Errorf("Expected /v1/model-deployments/deploy-123, got %s", r.URL.Path)
 			}
 
 			w.Header().Set("Content-Type", "application/json")
 			w.WriteHeader(http.StatusOK)
-			_ = json.NewEncoder(w).Encode(map[string]interface{}{
-				"_embedded": []map[string]interface{}{
-					{"id": "chat", "name": "Chat"},
-					{"id": "completion", "name": "Completion"},
-				},
+			_ = json.NewEncoder(w).Encode(ModelDeployment{
+				ID:             "deploy-123",
+				Name:           "test-deployment",
+				ProviderID:     "prov-123",
+				SupportedTasks: []string{"chat"},
+				Configuration:  map[string]string{"model": "gpt-4"},
+				CreatedBy:      "user-1",
+				CreatedAt:      "2024-01-01T00:00:00Z",
 			})
 		}
 
 		server, client := setupTestServer(t, handler)
 		defer server.Close()
 
-		result, err := client.ListCapabilityTypes(context.Background())
+		result, err := client.GetModelDeployment(context.Background(), "deploy-123")
 
 		if err != nil {
 			t.Fatalf("Unexpected error: %v", err)
 		}
-		if len(result.Embedded) != 2 {
-			t.Errorf("Expected 2 capability types, got %d", len(result.Embedded))
+		if result.ID != "deploy-123" {
+			t.Errorf("Expected ID 'deploy-123', got %s", result.ID)
+		}
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		}
+
+		server, client := setupTestServer(t, handler)
+		defer server.Close()
+
+		_, err := client.GetModelDeployment(context.Background(), "nonexistent")
+
+		if err == nil {
+			t.Fatal("Expected error but got nil")
+		}
+		if !errors.Is(err, ErrNotFound) {
+			t.Errorf("Expected ErrNotFound, got %v", err)
 		}
 	})
 }
 
-// TestGetCapabilityType tests the GetCapabilityType method.
-func TestGetCapabilityType(t *testing.T) {
+// TestCreateModelProvider tests the CreateModelProvider method.
+func TestCreateModelProvider(t *testing.T) {
+	t.Run("successful creation", func(t *testing.T) {
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPost {
+				t.Errorf("Expected POST, got %s", r.Method)
+			}
+			if r.URL.Path != "/v1/model-providers" {
+				t.Errorf("Expected /v1/model-providers, got %s", r.URL.Path)
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_ = json.NewEncoder(w).Encode(ModelProvider{
+				ID:            "prov-123",
+				Name:          "test-provider",
+				ProviderType:  "openai",
+				Configuration: map[string]string{"api_key": "secret"},
+				CreatedBy:     "user-1",
+				CreatedAt:     "2024-01-01T00:00:00Z",
+			})
+		}
+
+		server, client := setupTestServer(t, handler)
+		defer server.Close()
+
+		result, err := client.CreateModelProvider(context.Background(), ModelProviderCreate{
+			Name:          "test-provider",
+			ProviderType:  "openai",
+			Configuration: map[string]string{"api_key": "secret"},
+		})
+
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if result.ID != "prov-123" {
+			t.Errorf("Expected ID 'prov-123', got %s", result.ID)
+		}
+	})
+}
+
+// TestGetModelProvider tests the GetModelProvider method.
+func TestGetModelProvider(t *testing.T) {
 	t.Run("successful get", func(t *testing.T) {
 		handler := func(w http.ResponseWriter, r *http.Request) {
 			if r.Method != http.MethodGet {
 				t.Errorf("Expected GET, got %s", r.Method)
 			}
-			if r.URL.Path != "/v1/capability-types/chat" {
-				t.Errorf("Expected /v1/capability-types/chat, got %s", r.URL.Path)
+			if r.URL.Path != "/v1/model-providers/prov-123" {
+				t.Errorf("Expected /v1/model-providers/prov-123, got %s", r.URL.Path)
 			}
 
 			w.Header().Set("Content-Type", "application/json")
 			w.WriteHeader(http.StatusOK)
-			_ = json.NewEncoder(w).Encode(map[string]interface{}{
-				"id":   "chat",
-				"name": "Chat",
+			_ = json.NewEncoder(w).Encode(ModelProvider{
+				ID:            "prov-123",
+				Name:          "test-provider",
+				ProviderType:  "openai",
+				Configuration: map[string]string{"api_key": "secret"},
+				CreatedBy:     "user-1",
+				CreatedAt:     "2024-01-01T00:00:00Z",
 			})
 		}
 
 		server, client := setupTestServer(t, handler)
 		defer server.Close()
 
-		result, err := client.GetCapabilityType(context.Background(), "chat")
+		result, err := client.GetModelProvider(context.Background(), "prov-123")
 
 		if err != nil {
 			t.Fatalf("Unexpected error: %v", err)
 		}
-		if result.Id != "chat" {
-			t.Errorf("Expected ID 'chat', got %s", result.Id)
+		if result.ID != "prov-123" {
+			t.Errorf("Expected ID 'prov-123', got %s", result.ID)
+		}
+	})
+}
+
+// TestAPIErrorIs tests the errors.Is functionality for APIError.
+func TestAPIErrorIs(t *testing.T) {
+	t.Run("is ErrNotFound", func(t *testing.T) {
+		err := &APIError{StatusCode: http.StatusNotFound, Message: "not found"}
+		if !errors.Is(err, ErrNotFound) {
+			t.Error("Expected error to match ErrNotFound")
+		}
+	})
+
+	t.Run("is not ErrNotFound", func(t *testing.T) {
+		err := &APIError{StatusCode: http.StatusBadRequest, Message: "bad request"}
+		if errors.Is(err, ErrNotFound) {
+			t.Error("Expected error to not match ErrNotFound")
 		}
 	})
 }
+
+// TestCreateCompletionCapability verifies that a completion-capability create
+// response is deserialized into *CompletionCapability rather than being
+// misclassified as a ChatCapability. The four capability schemas share most
+// fields, so the generator's default anyOf "try each in order" logic picks
+// ChatCapability for any response unless we use the type discriminator.
+func TestCreateCompletionCapability(t *testing.T) {
+	t.Run("response with type=completion deserializes as CompletionCapability", func(t *testing.T) {
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPost {
+				t.Errorf("Expected POST, got %s", r.Method)
+			}
+			if r.URL.Path != "/v1/capabilities" {
+				t.Errorf("Expected /v1/capabilities, got %s", r.URL.Path)
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"id":                "cap-123",
+				"name":              "Event Analysis",
+				"type":              "completion",
+				"owner":             "user-1",
+				"is_public":         true,
+				"semantic_id":       "event-analysis",
+				"created_by":        "user-1",
+				"updated_by":        "user-1",
+				"created_at":        "2024-01-15T10:30:00Z",
+				"updated_at":        "2024-01-15T10:30:00Z",
+				"system_prompt":     "you are a helpful assistant",
+				"completion_prompt": "summarize: {events}",
+				"output_type":       "text",
+				"variables":         []string{"events"},
+			})
+		}
+
+		server, client := setupTestServer(t, handler)
+		defer server.Close()
+
+		create := api.CompletionCapabilityCreate{
+			Name:             "Event Analysis",
+			Type:             "completion",
+			SystemPrompt:     "you are a helpful assistant",
+			CompletionPrompt: "summarize: {events}",
+			OutputType:       "text",
+		}
+
+		result, err := client.CreateCompletionCapability(context.Background(), create)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if result == nil {
+			t.Fatal("Expected non-nil result")
+		}
+		if result.Id != "cap-123" {
+			t.Errorf("Expected ID 'cap-123', got %s", result.Id)
+		}
+		if result.CompletionPrompt != "summarize: {events}" {
+			t.Errorf("Expected completion_prompt to round-trip, got %q", result.CompletionPrompt)
+		}
+		if result.OutputType != "text" {
+			t.Errorf("Expected output_type 'text', got %q", result.OutputType)
+		}
+	})
+}
+
+// TestCreateChatCapability verifies a chat-capability create response is
+// deserialized as *ChatCapability via the type discriminator.
+func TestCreateChatCapability(t *testing.T) {
+	t.Run("response with type=chat deserializes as ChatCapability", func(t *testing.T) {
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"id":             "cap-456",
+				"name":           "Corax Data",
+				"type":           "chat",
+				"owner":          "user-1",
+				"is_public":      true,
+				"semantic_id":    "corax-data-chatbot",
+				"created_by":     "user-1",
+				"updated_by":     "user-1",
+				"created_at":     "2024-01-15T10:30:00Z",
+				"updated_at":     "2024-01-15T10:30:00Z",
+				"system_prompt":  "you are corax",
+				"collection_ids": []string{"col-1", "col-2"},
+			})
+		}
+
+		server, client := setupTestServer(t, handler)
+		defer server.Close()
+
+		create := api.ChatCapabilityCreate{
+			Name:         "Corax Data",
+			Type:         "chat",
+			SystemPrompt: "you are corax",
+		}
+
+		result, err := client.CreateChatCapability(context.Background(), create)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if result == nil {
+			t.Fatal("Expected non-nil result")
+		}
+		if result.Id != "cap-456" {
+			t.Errorf("Expected ID 'cap-456', got %s", result.Id)
+		}
+		if len(result.CollectionIds) != 2 {
+			t.Errorf("Expected 2 collection_ids, got %d", len(result.CollectionIds))
+		}
+	})
+}
+
+// TestCreateExtractionCapability verifies that extraction create responses
+// are routed to ExtractionCapability rather than a similarly shaped type.
+func TestCreateExtractionCapability(t *testing.T) {
+	t.Run("response with type=extraction deserializes as ExtractionCapability", func(t *testing.T) {
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			var body map[string]interface{}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			if body["type"] != "extraction" {
+				t.Errorf("Expected type 'extraction' in request, got %v", body["type"])
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"id":            "cap-789",
+				"name":          "Invoice Extractor",
+				"type":          "extraction",
+				"owner":         "user-1",
+				"created_by":    "user-1",
+				"updated_by":    "user-1",
+				"created_at":    "2024-01-15T10:30:00Z",
+				"updated_at":    "2024-01-15T10:30:00Z",
+				"system_prompt": "extract invoice fields",
+				"output_type":   "schema",
+			})
+		}
+
+		server, client := setupTestServer(t, handler)
+		defer server.Close()
+
+		create := api.NewExtractionCapabilityCreate("Invoice Extractor", "extraction")
+		create.SetSystemPrompt("extract invoice fields")
+
+		result, err := client.CreateExtractionCapability(context.Background(), *create)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if result.Id != "cap-789" {
+			t.Errorf("Expected ID 'cap-789', got %s", result.Id)
+		}
+		if result.GetOutputType() != "schema" {
+			t.Errorf("Expected output_type 'schema', got %s", result.GetOutputType())
+		}
+	})
+
+	t.Run("non-extraction response returns error", func(t *testing.T) {
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"id":            "cap-789",
+				"name":          "Chat",
+				"type":          "chat",
+				"owner":         "user-1",
+				"created_by":    "user-1",
+				"updated_by":    "user-1",
+				"created_at":    "2024-01-15T10:30:00Z",
+				"updated_at":    "2024-01-15T10:30:00Z",
+				"system_prompt": "hi",
+			})
+		}
+
+		server, client := setupTestServer(t, handler)
+		defer server.Close()
+
+		_, err := client.CreateExtractionCapability(context.Background(), *api.NewExtractionCapabilityCreate("Chat", "extraction"))
+		if err == nil {
+			t.Fatal("Expected error but got nil")
+		}
+	})
+}
+
+// TestGetCapability tests the GetCapability method.
+func TestGetCapability(t *testing.T) {
+	t.Run("successful get", func(t *testing.T) {
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodGet {
+				t.Errorf("Expected GET, got %s", r.Method)
+			}
+			if r.URL.Path != "/v1/capabilities/cap-123" {
+				t.Errorf("Expected /v1/capabilities/cap-123, got %s", r.URL.Path)
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"id":                 "cap-123",
+				"name":               "test-capability",
+				"type":               "chat",
+				"owner":              "user-1",
+				"is_public":          false,
+				"semantic_id":        "my-chat-capability",
+				"created_by":         "user-1",
+				"updated_by":         "user-1",
+				"created_at":         "2024-01-15T10:30:00Z",
+				"updated_at":         "2024-01-15T10:30:00Z",
+				"input":              map[string]interface{}{},
+				"output":             map[string]interface{}{},
+				"configuration":      map[string]interface{}{},
+				"is_default_version": true,
+			})
+		}
+
+		server, client := setupTestServer(t, handler)
+		defer server.Close()
+
+		result, err := client.GetCapability(context.Background(), "cap-123")
+
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if result.Id != "cap-123" {
+			t.Errorf("Expected ID 'cap-123', got %s", result.Id)
+		}
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		}
+
+		server, client := setupTestServer(t, handler)
+		defer server.Close()
+
+		_, err := client.GetCapability(context.Background(), "nonexistent")
+
+		if err == nil {
+			t.Fatal("Expected error but got nil")
+		}
+		if !errors.Is(err, ErrNotFound) {
+			t.Errorf("Expected ErrNotFound, got %v", err)
+		}
+	})
+}
+
+// TestDeleteCapability tests the DeleteCapability method.
+func TestDeleteCapability(t *testing.T) {
+	t.Run("successful delete", func(t *testing.T) {
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodDelete {
+				t.Errorf("Expected DELETE, got %s", r.Method)
+			}
+			if r.URL.Path != "/v1/capabilities/cap-123" {
+				t.Errorf("Expected /v1/capabilities/cap-123, got %s", r.URL.Path)
+			}
+
+			w.WriteHeader(http.StatusNoContent)
+		}
+
+		server, client := setupTestServer(t, handler)
+		defer server.Close()
+
+		err := client.DeleteCapability(context.Background(), "cap-123")
+
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	})
+}
+
+// TestListCapabilityTypes tests the ListCapabilityTypes method.
+func TestListCapabilityTypes(t *testing.T) {
+	t.Run("successful list", func(t *testing.T) {
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodGet {
+				t.Errorf("Expected GET, got %s", r.Method)
+			}
+			if r.URL.Path != "/v1/capability-types" {
+				t.Errorf("Expected /v1/capability-types, got %s", r.URL.Path)
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"_embedded": []map[string]interface{}{
+					{"id": "chat", "name": "Chat"},
+					{"id": "completion", "name": "Completion"},
+				},
+			})
+		}
+
+		server, client := setupTestServer(t, handler)
+		defer server.Close()
+
+		result, err := client.ListCapabilityTypes(context.Background())
+
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(result.Embedded) != 2 {
+			t.Errorf("Expected 2 capability types, got %d", len(result.Embedded))
+		}
+	})
+}
+
+// TestGetCapabilityType tests the GetCapabilityType method.
+func TestGetCapabilityType(t *testing.T) {
+	t.Run("successful get", func(t *testing.T) {
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodGet {
+				t.Errorf("Expected GET, got %s", r.Method)
+			}
+			if r.URL.Path != "/v1/capability-types/chat" {
+				t.Errorf("Expected /v1/capability-types/chat, got %s", r.URL.Path)
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"id":   "chat",
+				"name": "Chat",
+			})
+		}
+
+		server, client := setupTestServer(t, handler)
+		defer server.Close()
+
+		result, err := client.GetCapabilityType(context.Background(), "chat")
+
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if result.Id != "chat" {
+			t.Errorf("Expected ID 'chat', got %s", result.Id)
+		}
+	})
+}
+
+// TestCreateEvaluationDataset tests the CreateEvaluationDataset method.
+func TestCreateEvaluationDataset(t *testing.T) {
+	t.Run("successful creation", func(t *testing.T) {
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPost {
+				t.Errorf("Expected POST, got %s", r.Method)
+			}
+			if r.URL.Path != "/v1/evaluation-datasets" {
+				t.Errorf("Expected /v1/evaluation-datasets, got %s", r.URL.Path)
+			}
+
+			var body map[string]interface{}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			config, _ := body["configuration"].(map[string]interface{})
+			if config["output_type"] != "json" {
+				t.Errorf("Expected configuration.output_type 'json', got %v", config["output_type"])
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"id":            "ds-123",
+				"name":          "Golden Set",
+				"is_public":     false,
+				"configuration": map[string]interface{}{"output_type": "json", "input_variables": []string{"question"}},
+				"items_count":   0,
+			})
+		}
+
+		server, client := setupTestServer(t, handler)
+		defer server.Close()
+
+		config := api.NewEvaluationDatasetConfigurationBase(api.EVALUATION_DATASET_OUTPUT_TYPE_JSON)
+		config.SetInputVariables([]string{"question"})
+		result, err := client.CreateEvaluationDataset(context.Background(), *api.NewEvaluationDatasetCreate("Golden Set", *config))
+
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if result.Id != "ds-123" {
+			t.Errorf("Expected ID 'ds-123', got %s", result.Id)
+		}
+		if got := result.Configuration.GetInputVariables(); len(got) != 1 || got[0] != "question" {
+			t.Errorf("Expected input_variables [question], got %v", got)
+		}
+	})
+}
+
+// TestUpdateEvaluationDataset tests the UpdateEvaluationDataset method.
+func TestUpdateEvaluationDataset(t *testing.T) {
+	t.Run("sets id in body", func(t *testing.T) {
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPut {
+				t.Errorf("Expected PUT, got %s", r.Method)
+			}
+			if r.URL.Path != "/v1/evaluation-datasets/ds-123" {
+				t.Errorf("Expected /v1/evaluation-datasets/ds-123, got %s", r.URL.Path)
+			}
+
+			var body map[string]interface{}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			if body["id"] != "ds-123" {
+				t.Errorf("Expected id 'ds-123' in body, got %v", body["id"])
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"id":            "ds-123",
+				"name":          "Renamed",
+				"configuration": map[string]interface{}{"output_type": "text"},
+			})
+		}
+
+		server, client := setupTestServer(t, handler)
+		defer server.Close()
+
+		config := api.NewEvaluationDatasetConfigurationBase(api.EVALUATION_DATASET_OUTPUT_TYPE_TEXT)
+		result, err := client.UpdateEvaluationDataset(context.Background(), "ds-123", *api.NewEvaluationDatasetUpdate("Renamed", *config, ""))
+
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if result.Name != "Renamed" {
+			t.Errorf("Expected name 'Renamed', got %s", result.Name)
+		}
+	})
+
+	t.Run("empty dataset ID", func(t *testing.T) {
+		_, client := setupTestServer(t, nil)
+
+		_, err := client.UpdateEvaluationDataset(context.Background(), "", api.EvaluationDatasetUpdate{})
+		if err == nil {
+			t.Fatal("Expected error for empty dataset ID")
+		}
+	})
+}
+
+// TestAddEvaluationDatasetItems tests the AddEvaluationDatasetItems method.
+func TestAddEvaluationDatasetItems(t *testing.T) {
+	t.Run("posts each item", func(t *testing.T) {
+		ids := []string{"item-1", "item-2"}
+		calls := 0
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPost {
+				t.Errorf("Expected POST, got %s", r.Method)
+			}
+			if r.URL.Path != "/v1/evaluation-datasets/ds-123/items" {
+				t.Errorf("Expected /v1/evaluation-datasets/ds-123/items, got %s", r.URL.Path)
+			}
+			id := ids[calls]
+			calls++
+
+			var body map[string]interface{}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"id":     id,
+				"input":  body["input"],
+				"output": body["output"],
+			})
+		}
+
+		server, client := setupTestServer(t, handler)
+		defer server.Close()
+
+		items := []api.EvaluationDatasetItemCreate{
+			*api.NewEvaluationDatasetItemCreate(map[string]string{"question": "a"}, map[string]interface{}{"answer": "1"}),
+			*api.NewEvaluationDatasetItemCreate(map[string]string{"question": "b"}, map[string]interface{}{"answer": "2"}),
+		}
+		result, err := client.AddEvaluationDatasetItems(context.Background(), "ds-123", items)
+
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if calls != 2 {
+			t.Errorf("Expected 2 requests, got %d", calls)
+		}
+		if len(result) != 2 || result[0].Id != "item-1" || result[1].Id != "item-2" {
+			t.Errorf("Unexpected created items: %+v", result)
+		}
+	})
+
+	t.Run("returns created items on failure", func(t *testing.T) {
+		calls := 0
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.Header().Set("Content-Type", "application/json")
+			if calls > 1 {
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				_, _ = w.Write([]byte(`{"detail": "invalid item"}`))
+				return
+			}
+			w.WriteHeader(http.StatusCreated)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"id":     "item-1",
+				"input":  map[string]string{"question": "a"},
+				"output": map[string]interface{}{"answer": "1"},
+			})
+		}
+
+		server, client := setupTestServer(t, handler)
+		defer server.Close()
+
+		items := []api.EvaluationDatasetItemCreate{
+			*api.NewEvaluationDatasetItemCreate(map[string]string{"question": "a"}, map[string]interface{}{"answer": "1"}),
+			*api.NewEvaluationDatasetItemCreate(map[string]string{"question": "b"}, map[string]interface{}{"answer": "2"}),
+		}
+		result, err := client.AddEvaluationDatasetItems(context.Background(), "ds-123", items)
+
+		if err == nil {
+			t.Fatal("Expected error but got nil")
+		}
+		if len(result) != 1 || result[0].Id != "item-1" {
+			t.Errorf("Expected the first item to be returned, got %+v", result)
+		}
+	})
+}
+
+// TestGetEvaluationDatasetItem tests the GetEvaluationDatasetItem method.
+func TestGetEvaluationDatasetItem(t *testing.T) {
+	t.Run("not found", func(t *testing.T) {
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/v1/evaluation-datasets/ds-123/items/item-404" {
+				t.Errorf("Expected /v1/evaluation-datasets/ds-123/items/item-404, got %s", r.URL.Path)
+			}
+			w.WriteHeader(http.StatusNotFound)
+		}
+
+		server, client := setupTestServer(t, handler)
+		defer server.Close()
+
+		_, err := client.GetEvaluationDatasetItem(context.Background(), "ds-123", "item-404")
+		if !errors.Is(err, ErrNotFound) {
+			t.Errorf("Expected ErrNotFound, got %v", err)
+		}
+	})
+
+	t.Run("empty item ID", func(t *testing.T) {
+		_, client := setupTestServer(t, nil)
+
+		_, err := client.GetEvaluationDatasetItem(context.Background(), "ds-123", "")
+		if err == nil {
+			t.Fatal("Expected error for empty item ID")
+		}
+	})
+}
+
+// TestListEvaluationDatasetItems tests the ListEvaluationDatasetItems method.
+func TestListEvaluationDatasetItems(t *testing.T) {
+	t.Run("follows pagination", func(t *testing.T) {
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/v1/evaluation-datasets/ds-123/items" {
+				t.Errorf("Expected /v1/evaluation-datasets/ds-123/items, got %s", r.URL.Path)
+			}
+
+			page, id := 1, "item-1"
+			if r.URL.Query().Get("page") == "2" {
+				page, id = 2, "item-2"
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"_embedded": []map[string]interface{}{
+					{"id": id, "input": map[string]string{"q": id}, "output": map[string]interface{}{"a": id}},
+				},
+				"page": map[string]interface{}{"number": page, "size": 1, "total_elements": 2, "total_pages": 2},
+			})
+		}
+
+		server, client := setupTestServer(t, handler)
+		defer server.Close()
+
+		result, err := client.ListEvaluationDatasetItems(context.Background(), "ds-123")
+
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(result) != 2 || result[0].Id != "item-1" || result[1].Id != "item-2" {
+			t.Errorf("Expected items [item-1 item-2], got %+v", result)
+		}
+	})
+}
+
+// TestCreateCapabilityEvaluation tests the CreateCapabilityEvaluation method.
+func TestCreateCapabilityEvaluation(t *testing.T) {
+	t.Run("successful creation", func(t *testing.T) {
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPost {
+				t.Errorf("Expected POST, got %s", r.Method)
+			}
+			if r.URL.Path != "/v1/capability-evaluations" {
+				t.Errorf("Expected /v1/capability-evaluations, got %s", r.URL.Path)
+			}
+
+			var body map[string]interface{}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			if body["capability_id"] != "cap-123" || body["evaluation_dataset_id"] != "ds-123" {
+				t.Errorf("Unexpected request body: %v", body)
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"id":                    "eval-123",
+				"name":                  "Nightly",
+				"capability_id":         "cap-123",
+				"evaluation_dataset_id": "ds-123",
+				"configuration":         map[string]interface{}{"success_threshold": 0.8},
+				"created_at":            "2024-01-15T10:30:00Z",
+				"created_by":            "user-1",
+			})
+		}
+
+		server, client := setupTestServer(t, handler)
+		defer server.Close()
+
+		config := api.NewCapabilityEvaluationConfiguration()
+		config.SetSuccessThreshold(0.8)
+		result, err := client.CreateCapabilityEvaluation(context.Background(), *api.NewCapabilityEvaluationCreate("Nightly", "cap-123", "ds-123", *config))
+
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if result.Id != "eval-123" {
+			t.Errorf("Expected ID 'eval-123', got %s", result.Id)
+		}
+	})
+}
+
+// TestUpdateEvaluationCriterion tests the UpdateEvaluationCriterion method.
+func TestUpdateEvaluationCriterion(t *testing.T) {
+	t.Run("successful update", func(t *testing.T) {
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPut {
+				t.Errorf("Expected PUT, got %s", r.Method)
+			}
+			if r.URL.Path != "/v1/capability-evaluations/eval-123/criteria/crit-1" {
+				t.Errorf("Expected /v1/capability-evaluations/eval-123/criteria/crit-1, got %s", r.URL.Path)
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"id":            "crit-1",
+				"type":          "bleu",
+				"configuration": map[string]interface{}{"threshold": 0.5},
+			})
+		}
+
+		server, client := setupTestServer(t, handler)
+		defer server.Close()
+
+		update := api.NewCapabilityEvaluationCriterionCreate(api.BLEU, map[string]interface{}{"threshold": 0.5})
+		result, err := client.UpdateEvaluationCriterion(context.Background(), "eval-123", "crit-1", *update)
+
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if result.GetId() != "crit-1" {
+			t.Errorf("Expected ID 'crit-1', got %s", result.GetId())
+		}
+	})
+
+	t.Run("empty criterion ID", func(t *testing.T) {
+		_, client := setupTestServer(t, nil)
+
+		_, err := client.UpdateEvaluationCriterion(context.Background(), "eval-123", "", api.CapabilityEvaluationCriterionCreate{})
+		if err == nil {
+			t.Fatal("Expected error for empty criterion ID")
+		}
+	})
+}
+
+// TestListEvaluationCriterionTypes tests the ListEvaluationCriterionTypes method.
+func TestListEvaluationCriterionTypes(t *testing.T) {
+	t.Run("successful list", func(t *testing.T) {
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/v1/criterion-types" {
+				t.Errorf("Expected /v1/criterion-types, got %s", r.URL.Path)
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"_embedded": []map[string]interface{}{
+					{
+						"name":                   "BLEU",
+						"type":                   "bleu",
+						"operation":              "generation",
+						"valid_capability_types": []string{"completion"},
+						"description":            "BLEU score",
+						"configuration_fields": []map[string]interface{}{
+							{"name": "threshold", "label": "Threshold", "type": "number", "required": true},
+						},
+					},
+				},
+				"page": map[string]interface{}{"number": 1, "size": 100, "total_elements": 1, "total_pages": 1},
+			})
+		}
+
+		server, client := setupTestServer(t, handler)
+		defer server.Close()
+
+		result, err := client.ListEvaluationCriterionTypes(context.Background())
+
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(result) != 1 || result[0].Type != api.BLEU {
+			t.Fatalf("Expected one bleu criterion type, got %+v", result)
+		}
+		if len(result[0].ConfigurationFields) != 1 || result[0].ConfigurationFields[0].Name != "threshold" {
+			t.Errorf("Unexpected configuration fields: %+v", result[0].ConfigurationFields)
+		}
+	})
+}
+
+func TestExecuteCapabilityEvaluation(t *testing.T) {
+	t.Run("successful execution", func(t *testing.T) {
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPost {
+				t.Errorf("Expected POST, got %s", r.Method)
+			}
+			if r.URL.Path != "/v1/capability-evaluations/eval-123/executions" {
+				t.Errorf("Expected /v1/capability-evaluations/eval-123/executions, got %s", r.URL.Path)
+			}
+
+			var body map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Fatalf("Failed to decode request body: %v", err)
+			}
+			if body["evaluation_id"] != "eval-123" {
+				t.Errorf("Expected evaluation_id eval-123, got %v", body["evaluation_id"])
+			}
+			configuration, _ := body["configuration"].(map[string]interface{})
+			if configuration["mode"] != "subset" {
+				t.Errorf("Expected subset mode, got %v", configuration["mode"])
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"id":                          "exec-1",
+				"status":                      "pending",
+				"progress":                    map[string]interface{}{"total": 2, "pending": 2, "successful": 0, "failed": 0},
+				"created_at":                  "2024-01-01T00:00:00Z",
+				"passed":                      nil,
+				"success_rate":                nil,
+				"status_display":              "Pending",
+				"is_test_run":                 true,
+				"selected_dataset_item_count": 1,
+			})
+		}
+
+		server, client := setupTestServer(t, handler)
+		defer server.Close()
+
+		configuration := api.NewEvaluationExecutionConfiguration()
+		configuration.SetMode(api.SUBSET)
+		configuration.SetDatasetItemIds([]string{"item-1"})
+		execution := api.NewEvaluationExecutionCreate("eval-123")
+		execution.SetConfiguration(*configuration)
+
+		result, err := client.ExecuteCapabilityEvaluation(context.Background(), "eval-123", *execution)
+
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if result.Id != "exec-1" || result.Status != api.PENDING {
+			t.Errorf("Unexpected execution: %+v", result)
+		}
+		if result.Progress.Total != 2 {
+			t.Errorf("Expected progress total 2, got %d", result.Progress.Total)
+		}
+	})
+
+	t.Run("empty evaluation ID", func(t *testing.T) {
+		_, client := setupTestServer(t, nil)
+		_, err := client.ExecuteCapabilityEvaluation(context.Background(), "", *api.NewEvaluationExecutionCreate(""))
+		if err == nil || err.Error() != "evaluationID cannot be empty" {
+			t.Errorf("Expected empty evaluationID error, got %v", err)
+		}
+	})
+}
+
+func TestListEvaluationCriterionExecutions(t *testing.T) {
+	t.Run("successful list", func(t *testing.T) {
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/v1/capability-evaluations/eval-123/executions/exec-1/criteria-executions" {
+				t.Errorf("Unexpected path %s", r.URL.Path)
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"_embedded": []map[string]interface{}{
+					{
+						"id":           "ce-1",
+						"status":       "completed",
+						"criterion_id": "crit-1",
+						"result": map[string]interface{}{
+							"successful": true,
+							"test_results": []map[string]interface{}{
+								{"name": "test_case_0", "success": true, "metrics_data": []interface{}{}, "conversational": false},
+							},
+						},
+					},
+				},
+				"page": map[string]interface{}{"number": 1, "size": 100, "total_elements": 1, "total_pages": 1},
+			})
+		}
+
+		server, client := setupTestServer(t, handler)
+		defer server.Close()
+
+		result, err := client.ListEvaluationCriterionExecutions(context.Background(), "eval-123", "exec-1")
+
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(result) != 1 || result[0].GetCriterionId() != "crit-1" {
+			t.Fatalf("Expected one criterion execution for crit-1, got %+v", result)
+		}
+		if r := result[0].GetResult(); !r.GetSuccessful() || len(r.TestResults) != 1 {
+			t.Errorf("Unexpected result: %+v", r)
+		}
+	})
+
+	t.Run("empty execution ID", func(t *testing.T) {
+		_, client := setupTestServer(t, nil)
+		_, err := client.ListEvaluationCriterionExecutions(context.Background(), "eval-123", "")
+		if err == nil || err.Error() != "executionID cannot be empty" {
+			t.Errorf("Expected empty executionID error, got %v", err)
+		}
+	})
+}
+
+func TestCreateCompliancePolicy(t *testing.T) {
+	t.Run("successful creation", func(t *testing.T) {
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPost {
+				t.Errorf("Expected POST, got %s", r.Method)
+			}
+			if r.URL.Path != "/v1/compliance/policies" {
+				t.Errorf("Expected /v1/compliance/policies, got %s", r.URL.Path)
+			}
+
+			var body map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Fatalf("Failed to decode request body: %v", err)
+			}
+			controls, _ := body["controls"].(map[string]interface{})
+			if _, ok := controls["prompt_shield"]; !ok {
+				t.Errorf("Expected prompt_shield control, got %v", controls)
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"id":         "policy-1",
+				"name":       body["name"],
+				"scope_type": "global",
+				"controls":   map[string]interface{}{"prompt_shield": map[string]interface{}{"enabled": true}},
+				"is_active":  true,
+				"created_by": "user-1",
+				"updated_by": "user-1",
+				"created_at": "2024-01-01T00:00:00Z",
+				"updated_at": "2024-01-01T00:00:00Z",
+			})
+		}
+
+		server, client := setupTestServer(t, handler)
+		defer server.Close()
+
+		controls := api.NewPolicyControlsInput()
+		controls.SetPromptShield(*api.NewPromptShieldRequirement())
+		result, err := client.CreateCompliancePolicy(context.Background(), *api.NewPolicyCreate("Baseline", api.GLOBAL, *controls))
+
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if result.Id != "policy-1" || result.Name != "Baseline" {
+			t.Errorf("Unexpected policy: %+v", result)
+		}
+		if !result.Controls.PromptShield.IsSet() || !result.Controls.PromptShield.Get().GetEnabled() {
+			t.Errorf("Expected enabled prompt shield, got %+v", result.Controls)
+		}
+	})
+}
+
+func TestUpdateCompliancePolicy(t *testing.T) {
+	t.Run("successful update", func(t *testing.T) {
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPatch {
+				t.Errorf("Expected PATCH, got %s", r.Method)
+			}
+			if r.URL.Path != "/v1/compliance/policies/policy-1" {
+				t.Errorf("Expected /v1/compliance/policies/policy-1, got %s", r.URL.Path)
+			}
+
+			var body map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Fatalf("Failed to decode request body: %v", err)
+			}
+			if value, ok := body["description"]; !ok || value != nil {
+				t.Errorf("Expected description to be cleared with null, got %v", body["description"])
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"id":         "policy-1",
+				"name":       "Renamed",
+				"scope_type": "global",
+				"controls":   map[string]interface{}{},
+				"is_active":  false,
+				"created_by": "user-1",
+				"updated_by": "user-1",
+				"created_at": "2024-01-01T00:00:00Z",
+				"updated_at": "2024-01-02T00:00:00Z",
+			})
+		}
+
+		server, client := setupTestServer(t, handler)
+		defer server.Close()
+
+		update := api.NewPolicyUpdate()
+		update.SetName("Renamed")
+		update.SetDescriptionNil()
+		update.SetIsActive(false)
+		result, err := client.UpdateCompliancePolicy(context.Background(), "policy-1", *update)
+
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if result.Name != "Renamed" || result.IsActive {
+			t.Errorf("Unexpected policy: %+v", result)
+		}
+	})
+
+	t.Run("empty policy ID", func(t *testing.T) {
+		_, client := setupTestServer(t, nil)
+		_, err := client.UpdateCompliancePolicy(context.Background(), "", *api.NewPolicyUpdate())
+		if err == nil || err.Error() != "policyID cannot be empty" {
+			t.Errorf("Expected empty policyID error, got %v", err)
+		}
+	})
+}
+
+func TestApplyCompliancePolicyToCapability(t *testing.T) {
+	t.Run("successful apply", func(t *testing.T) {
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPut {
+				t.Errorf("Expected PUT, got %s", r.Method)
+			}
+			if r.URL.Path != "/v1/compliance/capabilities/cap-1/policies/policy-1" {
+				t.Errorf("Unexpected path %s", r.URL.Path)
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"applied_controls": []map[string]interface{}{
+					{"control": "prompt_shield", "policy_name": "Baseline"},
+				},
+				"message": "Applied 1 control",
+			})
+		}
+
+		server, client := setupTestServer(t, handler)
+		defer server.Close()
+
+		result, err := client.ApplyCompliancePolicyToCapability(context.Background(), "cap-1", "policy-1")
+
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(result.AppliedControls) != 1 || result.AppliedControls[0].Control != "prompt_shield" {
+			t.Errorf("Unexpected applied controls: %+v", result.AppliedControls)
+		}
+	})
+
+	t.Run("empty policy ID", func(t *testing.T) {
+		_, client := setupTestServer(t, nil)
+		_, err := client.ApplyCompliancePolicyToCapability(context.Background(), "cap-1", " ")
+		if err == nil || err.Error() != "policyID cannot be empty" {
+			t.Errorf("Expected empty policyID error, got %v", err)
+		}
+	})
+}
+
+func TestGetApplicableCompliancePolicies(t *testing.T) {
+	t.Run("successful get", func(t *testing.T) {
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/v1/compliance/capabilities/cap-1/policies" {
+				t.Errorf("Unexpected path %s", r.URL.Path)
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_ = json.NewEncoder(w).Encode([]map[string]interface{}{
+				{"id": "policy-1", "name": "Baseline", "scope_type": "global", "controls_summary": []string{"prompt_shield"}, "is_applied": true},
+			})
+		}
+
+		server, client := setupTestServer(t, handler)
+		defer server.Close()
+
+		result, err := client.GetApplicableCompliancePolicies(context.Background(), "cap-1")
+
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(result) != 1 || result[0].Id != "policy-1" || !result[0].IsApplied {
+			t.Errorf("Unexpected policies: %+v", result)
+		}
+	})
+}
+
+func TestGetCompliancePolicyImpact(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/compliance/policies/policy-1/impact" {
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"affected_assets": 5, "non_compliant_assets": 2})
+	}
+
+	server, client := setupTestServer(t, handler)
+	defer server.Close()
+
+	result, err := client.GetCompliancePolicyImpact(context.Background(), "policy-1")
+
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.AffectedAssets != 5 || result.NonCompliantAssets != 2 {
+		t.Errorf("Unexpected impact: %+v", result)
+	}
+}
+
+func TestApplyCompliancePolicyToAll(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Expected POST, got %s", r.Method)
+		}
+		if r.URL.Path != "/v1/compliance/policies/policy-1/applications" {
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"message": "Applied to 1 capability",
+			"applied": []map[string]interface{}{{"capability_id": "cap-1"}},
+		})
+	}
+
+	server, client := setupTestServer(t, handler)
+	defer server.Close()
+
+	result, err := client.ApplyCompliancePolicyToAll(context.Background(), "policy-1")
+
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.Message != "Applied to 1 capability" {
+		t.Errorf("Unexpected message: %q", result.Message)
+	}
+	var body map[string]interface{}
+	if err := json.Unmarshal(result.Raw, &body); err != nil || len(body["applied"].([]interface{})) != 1 {
+		t.Errorf("Unexpected raw result: %s", result.Raw)
+	}
+}
+
+func TestDryRunGuardrails(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/v1/compliance/guardrail-scans" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("Failed to decode request body: %v", err)
+		}
+		if body["text"] != "My email is jane@example.com" || body["policy_id"] != "policy-1" {
+			t.Errorf("Unexpected request body: %v", body)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"results": []map[string]interface{}{
+				{"passed": false, "category": "pii_detection", "detected_items": []string{"EMAIL"}, "sanitized_text": "My email is <EMAIL>"},
+			},
+			"summary": "1 of 1 scans failed",
+		})
+	}
+
+	server, client := setupTestServer(t, handler)
+	defer server.Close()
+
+	request := api.NewDryRunRequest("My email is jane@example.com")
+	request.SetPolicyId("policy-1")
+	result, err := client.DryRunGuardrails(context.Background(), *request)
+
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(result.Results) != 1 || result.Results[0].Passed || result.Results[0].Category != "pii_detection" {
+		t.Errorf("Unexpected results: %+v", result.Results)
+	}
+}
+
+func TestListComplianceAssets(t *testing.T) {
+	t.Run("with filters", func(t *testing.T) {
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/v1/compliance/assets" {
+				t.Errorf("Unexpected path %s", r.URL.Path)
+			}
+			if r.URL.Query().Get("project_id") != "project-1" || r.URL.Query().Get("status") != "not_protected" {
+				t.Errorf("Unexpected query %s", r.URL.RawQuery)
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_ = json.NewEncoder(w).Encode([]map[string]interface{}{
+				{"capability_id": "cap-1", "capability_name": "Chat", "compliance_status": "not_protected", "policy_count": 1},
+			})
+		}
+
+		server, client := setupTestServer(t, handler)
+		defer server.Close()
+
+		result, err := client.ListComplianceAssets(context.Background(), "project-1", "not_protected")
+
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(result) != 1 || result[0].ComplianceStatus != "not_protected" {
+			t.Errorf("Unexpected assets: %+v", result)
+		}
+	})
+
+	t.Run("without filters", func(t *testing.T) {
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.RawQuery != "" {
+				t.Errorf("Expected no query, got %s", r.URL.RawQuery)
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte("[]"))
+		}
+
+		server, client := setupTestServer(t, handler)
+		defer server.Close()
+
+		result, err := client.ListComplianceAssets(context.Background(), "", "")
+
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(result) != 0 {
+			t.Errorf("Expected no assets, got %+v", result)
+		}
+	})
+}
+
+func TestListGuardrails(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/compliance/guardrails" {
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+		if r.URL.Query().Get("sort_by") != "project_name" || r.URL.Query().Get("sort_order") != "desc" {
+			t.Errorf("Unexpected query %s", r.URL.RawQuery)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode([]map[string]interface{}{
+			{"capability_id": "cap-1", "capability_name": "Chat", "prompt_shield_enabled": true, "control_compliance": map[string]string{"prompt_shield": "compliant"}},
+		})
+	}
+
+	server, client := setupTestServer(t, handler)
+	defer server.Close()
+
+	result, err := client.ListGuardrails(context.Background(), "", "project_name", "desc")
+
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(result) != 1 || !result[0].GetPromptShieldEnabled() || result[0].ControlCompliance["prompt_shield"] != "compliant" {
+		t.Errorf("Unexpected guardrails: %+v", result)
+	}
+}
+
+func TestListGuardrailEvents(t *testing.T) {
+	event := func(i int) map[string]interface{} {
+		return map[string]interface{}{
+			"id": fmt.Sprintf("event-%d", i), "timestamp": "2024-01-01T00:00:00Z", "capability_id": "cap-1", "capability_name": "Chat",
+			"execution_id": "exec-1", "policy_id": "policy-1", "policy_name": "Baseline", "control_type": "pii_detection",
+			"direction": "input", "reason": "PII detected", "detected_items": []string{"EMAIL"}, "scanner_version": "1",
+		}
+	}
+	// Serves 450 events in pages of at most the requested limit.
+	newHandler := func(t *testing.T, requests *[]string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			*requests = append(*requests, r.URL.RawQuery)
+			if r.URL.Path != "/v1/compliance/guardrail-events" {
+				t.Errorf("Unexpected path %s", r.URL.Path)
+			}
+			limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+			offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+			events := []map[string]interface{}{}
+			for i := offset; i < offset+limit && i < 450; i++ {
+				events = append(events, event(i))
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"events": events, "total": 450, "limit": limit, "offset": offset})
+		}
+	}
+
+	t.Run("reads all pages", func(t *testing.T) {
+		var requests []string
+		server, client := setupTestServer(t, newHandler(t, &requests))
+		defer server.Close()
+
+		events, total, err := client.ListGuardrailEvents(context.Background(), GuardrailEventFilter{}, 0)
+
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(events) != 450 || total != 450 || len(requests) != 3 {
+			t.Errorf("Expected 450 events in 3 requests, got %d events (total %d) in %d requests", len(events), total, len(requests))
+		}
+		if events[449].Id != "event-449" {
+			t.Errorf("Unexpected last event %s", events[449].Id)
+		}
+	})
+
+	t.Run("stops at the cap and sends filters", func(t *testing.T) {
+		var requests []string
+		server, client := setupTestServer(t, newHandler(t, &requests))
+		defer server.Close()
+
+		from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		filter := GuardrailEventFilter{PolicyID: "policy-1", ControlType: "pii_detection", Direction: "output", FromDate: &from}
+		events, total, err := client.ListGuardrailEvents(context.Background(), filter, 250)
+
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(events) != 250 || total != 450 || len(requests) != 2 {
+			t.Errorf("Expected 250 events in 2 requests, got %d events (total %d) in %d requests", len(events), total, len(requests))
+		}
+		query, _ := url.ParseQuery(requests[1])
+		if query.Get("limit") != "50" || query.Get("offset") != "200" || query.Get("policy_id") != "policy-1" ||
+			query.Get("control_type") != "pii_detection" || query.Get("direction") != "output" || query.Get("from_date") == "" || query.Has("to_date") {
+			t.Errorf("Unexpected second request %s", requests[1])
+		}
+	})
+}
+
+func TestGetRole(t *testing.T) {
+	role := func(id string) map[string]interface{} {
+		return map[string]interface{}{
+			"id": id, "name": "Role " + id, "permissions": []string{"projects:read"}, "is_built_in": false, "is_superuser": false,
+			"created_at": "2024-01-01T00:00:00Z", "updated_at": "2024-01-01T00:00:00Z",
+		}
+	}
+	// Serves role-1 on page 1 and role-2 on page 2.
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/admin/roles" {
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"_embedded": []map[string]interface{}{role(fmt.Sprintf("role-%d", page))},
+			"page":      map[string]interface{}{"number": page, "size": 1, "total_elements": 2, "total_pages": 2},
+		})
+	}
+
+	server, client := setupTestServer(t, handler)
+	defer server.Close()
+
+	t.Run("found on a later page", func(t *testing.T) {
+		result, err := client.GetRole(context.Background(), "role-2")
+
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if result.Name != "Role role-2" || len(result.Permissions) != 1 || result.Permissions[0] != api.PROJECTS_READ {
+			t.Errorf("Unexpected role: %+v", result)
+		}
+	})
+
+	t.Run("not in list", func(t *testing.T) {
+		_, err := client.GetRole(context.Background(), "role-3")
+
+		if !errors.Is(err, ErrNotFound) {
+			t.Errorf("Expected ErrNotFound, got %v", err)
+		}
+	})
+}
+
+func TestUpdateUser(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch || r.URL.Path != "/v1/admin/users/user-1" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		var body map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if body["role_id"] != "role-1" || body["is_active"] != false {
+			t.Errorf("Unexpected body: %v", body)
+		}
+		if _, ok := body["role_name"]; ok {
+			t.Errorf("Expected role_name to be omitted, got %v", body)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"id": "user-1", "username": "jane", "role_id": "role-1", "role_name": "Viewer", "is_active": false, "created_at": "2024-01-01T00:00:00Z",
+		})
+	}
+
+	server, client := setupTestServer(t, handler)
+	defer server.Close()
+
+	update := api.UserUpdate{}
+	update.SetRoleId("role-1")
+	update.SetIsActive(false)
+	result, err := client.UpdateUser(context.Background(), "user-1", update)
+
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.RoleName != "Viewer" || result.IsActive {
+		t.Errorf("Unexpected user: %+v", result)
+	}
+}
+
+func TestGetCurrentIdentity(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/auth/me" {
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"user_id": "user-1", "username": "jane", "role_name": "Admin", "permissions": []string{"users:manage"}, "auth_source": "api_key",
+		})
+	}
+
+	server, client := setupTestServer(t, handler)
+	defer server.Close()
+
+	result, err := client.GetCurrentIdentity(context.Background())
+
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.UserId != "user-1" || result.GetRoleName() != "Admin" || result.AuthSource != "api_key" || result.GetIsSuperuser() {
+		t.Errorf("Unexpected identity: %+v", result)
+	}
+}
+
+func TestPreflight(t *testing.T) {
+	// Serves the healthcheck, identity and features endpoints; meStatus controls the identity response.
+	newHandler := func(meStatus int) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch r.URL.Path {
+			case "/":
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(map[string]string{"status": "ok"})
+			case "/v1/auth/me":
+				w.WriteHeader(meStatus)
+				if meStatus != http.StatusOK {
+					_ = json.NewEncoder(w).Encode(map[string]string{"detail": "Invalid API key"})
+					return
+				}
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{"user_id": "user-1", "username": "jane", "auth_source": "api_key"})
+			case "/features":
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(map[string]bool{FeatureCompliance: true, FeatureMCPServers: false})
+			default:
+				t.Errorf("Unexpected path %s", r.URL.Path)
+			}
+		}
+	}
+
+	t.Run("stores features", func(t *testing.T) {
+		server, client := setupTestServer(t, newHandler(http.StatusOK))
+		defer server.Close()
+
+		if client.Features() != nil || client.FeatureDisabled(FeatureMCPServers) {
+			t.Errorf("Expected no features before preflight, got %v", client.Features())
+		}
+
+		me, err := client.Preflight(context.Background())
+
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if me.Username != "jane" || len(client.Features()) != 2 {
+			t.Errorf("Unexpected identity %+v or features %v", me, client.Features())
+		}
+		if !client.FeatureDisabled(FeatureMCPServers) || client.FeatureDisabled(FeatureCompliance) || client.FeatureDisabled("unreported") {
+			t.Errorf("Unexpected disabled features from %v", client.Features())
+		}
+	})
+
+	t.Run("rejected API key", func(t *testing.T) {
+		server, client := setupTestServer(t, newHandler(http.StatusUnauthorized))
+		defer server.Close()
+
+		_, err := client.Preflight(context.Background())
+
+		if err == nil || !strings.Contains(err.Error(), "the API key was rejected") {
+			t.Errorf("Expected a rejected API key error, got %v", err)
+		}
+		if client.Features() != nil {
+			t.Errorf("Expected no features after a failed preflight, got %v", client.Features())
+		}
+	})
+
+	t.Run("unreachable endpoint", func(t *testing.T) {
+		server, client := setupTestServer(t, newHandler(http.StatusOK))
+		server.Close()
+
+		_, err := client.Preflight(context.Background())
+
+		if err == nil || !strings.Contains(err.Error(), "healthcheck failed") {
+			t.Errorf("Expected a healthcheck error, got %v", err)
+		}
+	})
+}
+
+func TestGetFeatures(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/features" {
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(map[string]bool{"compliance": true, "mcp_servers": false})
+	}
+
+	server, client := setupTestServer(t, handler)
+	defer server.Close()
+
+	result, err := client.GetFeatures(context.Background())
+
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(result) != 2 || !result["compliance"] || result["mcp_servers"] {
+		t.Errorf("Unexpected features: %v", result)
+	}
+	// Reading the features does not make the client enforce them; only Preflight does.
+	if client.Features() != nil {
+		t.Errorf("Expected no stored features, got %v", client.Features())
+	}
+}
+
+func TestListAllPages(t *testing.T) {
+	t.Run("follows pages until the last", func(t *testing.T) {
+		var sizes []int32
+		items, err := listAllPages(func(page int32, size int32) ([]int32, int32, error) {
+			sizes = append(sizes, size)
+			return []int32{page * 10, page*10 + 1}, 3, nil
+		})
+
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(items) != 6 || items[5] != 31 || len(sizes) != 3 || sizes[0] != listPageSize {
+			t.Errorf("Unexpected items %v after requests with sizes %v", items, sizes)
+		}
+	})
+
+	t.Run("stops at an empty page", func(t *testing.T) {
+		calls := 0
+		items, err := listAllPages(func(page int32, size int32) ([]string, int32, error) {
+			calls++
+			if page > 1 {
+				return nil, 5, nil
+			}
+			return []string{"a"}, 5, nil
+		})
+
+		if err != nil || len(items) != 1 || calls != 2 {
+			t.Errorf("Expected 1 item in 2 calls, got %v in %d calls (err %v)", items, calls, err)
+		}
+	})
+
+	t.Run("returns the first error", func(t *testing.T) {
+		_, err := listAllPages(func(page int32, size int32) ([]string, int32, error) {
+			return nil, 0, ErrNotFound
+		})
+
+		if !errors.Is(err, ErrNotFound) {
+			t.Errorf("Expected ErrNotFound, got %v", err)
+		}
+	})
+}
+
+func TestListProjects(t *testing.T) {
+	var queries []url.Values
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/projects" {
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+		queries = append(queries, r.URL.Query())
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"_embedded": []map[string]interface{}{{
+				"id": fmt.Sprintf("project-%d", page), "name": fmt.Sprintf("Project %d", page), "created_by": "user-1", "updated_by": "user-1",
+				"created_at": "2024-01-01T00:00:00Z", "updated_at": "2024-01-01T00:00:00Z", "owner": "user-1",
+			}},
+			"page": map[string]interface{}{"number": page, "size": 1, "total_elements": 2, "total_pages": 2},
+		})
+	}
+
+	server, client := setupTestServer(t, handler)
+	defer server.Close()
+
+	projects, err := client.ListProjects(context.Background(), "is_public::true", "name")
+
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(projects) != 2 || projects[1].ID != "project-2" || projects[1].Name != "Project 2" {
+		t.Errorf("Unexpected projects: %+v", projects)
+	}
+	if len(queries) != 2 || queries[1].Get("filter") != "is_public::true" || queries[1].Get("sort") != "name" || queries[1].Get("size") != "100" {
+		t.Errorf("Unexpected queries: %v", queries)
+	}
+}
+
+func TestListCapabilities(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/capabilities" {
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+		if r.URL.Query().Get("size") != "100" {
+			t.Errorf("Unexpected page size %q", r.URL.Query().Get("size"))
+		}
+		if r.URL.Query().Get("filter") != "type::chat" {
+			t.Errorf("Unexpected filter %q", r.URL.Query().Get("filter"))
+		}
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"_embedded": []map[string]interface{}{{
+				"id": fmt.Sprintf("cap-%d", page), "name": fmt.Sprintf("Capability %d", page), "type": "chat", "semantic_id": fmt.Sprintf("capability-%d", page),
+				"created_by": "user-1", "updated_by": "user-1", "created_at": "2024-01-01T00:00:00Z", "updated_at": "2024-01-01T00:00:00Z", "owner": "user-1",
+			}},
+			"page": map[string]interface{}{"number": page, "size": 1, "total_elements": 2, "total_pages": 2},
+		})
+	}
+
+	server, client := setupTestServer(t, handler)
+	defer server.Close()
+
+	capabilities, err := client.ListCapabilities(context.Background(), "type::chat")
+
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(capabilities) != 2 || capabilities[1].Id != "cap-2" || capabilities[1].SemanticId != "capability-2" {
+		t.Errorf("Unexpected capabilities: %+v", capabilities)
+	}
+}
+
+func TestListCapabilityVersions(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/capabilities/cap-1/versions" {
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"_embedded": []map[string]interface{}{
+				{"id": "v-1", "capability_id": "cap-1", "version": 1, "created_at": "2024-01-01T00:00:00Z", "updated_at": "2024-01-01T00:00:00Z", "is_default_version": false},
+				{"id": "v-2", "capability_id": "cap-1", "version": 2, "created_at": "2024-01-02T00:00:00Z", "updated_at": "2024-01-02T00:00:00Z", "is_default_version": true},
+			},
+			"page": map[string]interface{}{"number": 1, "size": 2, "total_elements": 2, "total_pages": 1},
+		})
+	}
+
+	server, client := setupTestServer(t, handler)
+	defer server.Close()
+
+	versions, err := client.ListCapabilityVersions(context.Background(), "cap-1")
+
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(versions) != 2 || versions[1].Version != 2 || !versions[1].IsDefaultVersion {
+		t.Errorf("Unexpected versions: %+v", versions)
+	}
+
+	if _, err := client.ListCapabilityVersions(context.Background(), " "); err == nil {
+		t.Error("Expected an error for an empty capability ID")
+	}
+}
+
+func TestListModelDeployments(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/model-deployments" {
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"_embedded": []map[string]interface{}{{
+				"id": fmt.Sprintf("deployment-%d", page), "name": fmt.Sprintf("Deployment %d", page), "supported_tasks": []string{"chat"},
+				"configuration": map[string]interface{}{"model_name": "gpt-4o", "max_tokens": 1024}, "provider_id": "provider-1",
+				"created_at": "2024-01-01T00:00:00Z", "created_by": "user-1",
+			}},
+			"page": map[string]interface{}{"number": page, "size": 1, "total_elements": 2, "total_pages": 2},
+		})
+	}
+
+	server, client := setupTestServer(t, handler)
+	defer server.Close()
+
+	deployments, err := client.ListModelDeployments(context.Background())
+
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(deployments) != 2 || deployments[1].ID != "deployment-2" || deployments[1].Configuration["max_tokens"] != "1024" {
+		t.Errorf("Unexpected deployments: %+v", deployments)
+	}
+}
+
+func TestListModelProviders(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/model-providers" {
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"_embedded": []map[string]interface{}{{
+				"id": "provider-1", "name": "OpenAI", "provider_type": "openai", "configuration": map[string]interface{}{"api_key": "sk-...abcd"},
+				"created_at": "2024-01-01T00:00:00Z", "created_by": "user-1",
+			}},
+			"page": map[string]interface{}{"number": 1, "size": 1, "total_elements": 1, "total_pages": 1},
+		})
+	}
+
+	server, client := setupTestServer(t, handler)
+	defer server.Close()
+
+	providers, err := client.ListModelProviders(context.Background())
+
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(providers) != 1 || providers[0].ProviderType != "openai" || providers[0].Configuration["api_key"] != "sk-...abcd" {
+		t.Errorf("Unexpected providers: %+v", providers)
+	}
+}
+
+func TestGetModelProviderConfigurationFields(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/model-provider-types/openai/model-provider-configuration" {
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"configuration": []map[string]interface{}{
+				{"name": "api_key", "label": "API Key", "type": "key", "required": true},
+				{"name": "base_url", "label": "Base URL", "type": "url", "required": false},
+			},
+		})
+	}
+
+	server, client := setupTestServer(t, handler)
+	defer server.Close()
+
+	fields, err := client.GetModelProviderConfigurationFields(context.Background(), "openai")
+
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(fields) != 2 || fields[0].Name != "api_key" || fields[0].Type != api.FIELD_TYPE_KEY || !fields[0].Required {
+		t.Errorf("Unexpected fields: %+v", fields)
+	}
+
+	if _, err := client.GetModelProviderConfigurationFields(context.Background(), ""); err == nil {
+		t.Error("Expected an error for an empty provider type")
+	}
+}
+
+func TestListModelProviderTypes(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/model-provider-types" {
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"_embedded": []map[string]interface{}{{
+				"name": "openai", "label": "OpenAI",
+				"configuration":                  []map[string]interface{}{{"name": "api_key", "label": "API Key", "type": "key", "required": true}},
+				"model_deployment_configuration": []map[string]interface{}{{"name": "model_name", "label": "Model", "type": "text", "required": true}},
+			}},
+			"page": map[string]interface{}{"number": 1, "size": 1, "total_elements": 1, "total_pages": 1},
+		})
+	}
+
+	server, client := setupTestServer(t, handler)
+	defer server.Close()
+
+	providerTypes, err := client.ListModelProviderTypes(context.Background())
+
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(providerTypes) != 1 || providerTypes[0].Name != "openai" || providerTypes[0].ModelDeploymentConfiguration[0].Name != "model_name" {
+		t.Errorf("Unexpected provider types: %+v", providerTypes)
+	}
+}
+
+func TestGetModelDeploymentConfigurationFields(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/model-provider-types/openai/model-deployment-configuration" {
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"configuration": []map[string]interface{}{
+				{"name": "model_name", "label": "Model", "type": "text", "required": true},
+				{"name": "temperature", "label": "Temperature", "type": "number", "required": false, "min": 0, "max": 2},
+			},
+		})
+	}
+
+	server, client := setupTestServer(t, handler)
+	defer server.Close()
+
+	fields, err := client.GetModelDeploymentConfigurationFields(context.Background(), "openai")
+
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(fields) != 2 || fields[1].Name != "temperature" || fields[1].Type != api.FIELD_TYPE_NUMBER {
+		t.Errorf("Unexpected fields: %+v", fields)
+	}
+}
+
+func TestCachedModelProviderConfigurationFields(t *testing.T) {
+	requests := map[string]int{}
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		requests[r.URL.Path]++
+		if strings.Contains(r.URL.Path, "/unknown/") {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"detail":"Provider type not found"}`))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"configuration": []map[string]interface{}{{"name": "api_key", "label": "API Key", "type": "key", "required": true}},
+		})
+	}
+
+	server, client := setupTestServer(t, handler)
+	defer server.Close()
+
+	for i := 0; i < 2; i++ {
+		if _, err := client.CachedModelProviderConfigurationFields(context.Background(), "openai"); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if _, err := client.CachedModelDeploymentConfigurationFields(context.Background(), "openai"); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if _, err := client.CachedModelProviderConfigurationFields(context.Background(), "unknown"); !errors.Is(err, ErrNotFound) {
+			t.Fatalf("Expected ErrNotFound, got: %v", err)
+		}
+	}
+
+	if requests["/v1/model-provider-types/openai/model-provider-configuration"] != 1 || requests["/v1/model-provider-types/openai/model-deployment-configuration"] != 1 {
+		t.Errorf("Expected the fields to be fetched once per endpoint, got: %v", requests)
+	}
+	if requests["/v1/model-provider-types/unknown/model-provider-configuration"] != 2 {
+		t.Errorf("Expected errors not to be cached, got: %v", requests)
+	}
+}
+
+func TestCachedConfigurationFieldsConcurrent(t *testing.T) {
+	client := &Client{}
+	release := make(chan struct{})
+	fetches := make(chan string, 4)
+	fields := []api.ConfigurationField{{Name: "api_key"}}
+
+	slow := func() ([]api.ConfigurationField, error) {
+		fetches <- "slow"
+		<-release
+		return fields, nil
+	}
+
+	results := make(chan error, 2)
+	for i := 0; i < 2; i++ {
+		go func() {
+			_, err := client.cachedConfigurationFields("model-provider-configuration/slow", slow)
+			results <- err
+		}()
+	}
+	if got := <-fetches; got != "slow" {
+		t.Fatalf("Unexpected fetch %q", got)
+	}
+
+	// A different key is served while the slow fetch is still in flight.
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, _ = client.cachedConfigurationFields("model-provider-configuration/fast", func() ([]api.ConfigurationField, error) {
+			fetches <- "fast"
+			return fields, nil
+		})
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Fetch of another key was blocked by the in-flight fetch")
+	}
+
+	close(release)
+	for i := 0; i < 2; i++ {
+		if err := <-results; err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+	close(fetches)
+	var got []string
+	for fetch := range fetches {
+		got = append(got, fetch)
+	}
+	if len(got) != 1 || got[0] != "fast" {
+		t.Errorf("Expected a single fetch per key, got additional fetches %v", got)
+	}
+}
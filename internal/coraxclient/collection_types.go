@@ -0,0 +1,38 @@
+// Copyright (c) Trifork
+
+package coraxclient
+
+// CollectionCreate represents the request body for creating a knowledge collection.
+// Based on openapi.json components.schemas.CollectionCreate.
+type CollectionCreate struct {
+	Name        string  `json:"name"`
+	Description *string `json:"description,omitempty"`
+	IsPublic    *bool   `json:"is_public,omitempty"`  // API defaults to false if not provided
+	ProjectID   *string `json:"project_id,omitempty"` // Can be null
+}
+
+// CollectionUpdate represents the request body for updating a knowledge collection.
+// Based on openapi.json components.schemas.CollectionUpdate.
+// The API performs a full replacement, so all current values must be sent.
+type CollectionUpdate struct {
+	Name        string  `json:"name"`
+	Description *string `json:"description,omitempty"`
+	IsPublic    bool    `json:"is_public"`
+	ProjectID   *string `json:"project_id,omitempty"`
+}
+
+// Collection represents the knowledge collection details.
+// Based on openapi.json components.schemas.Collection.
+type Collection struct {
+	ID             string  `json:"id"`
+	Name           string  `json:"name"`
+	Description    *string `json:"description,omitempty"`
+	IsPublic       bool    `json:"is_public"`
+	ProjectID      *string `json:"project_id,omitempty"` // Can be null
+	CreatedBy      string  `json:"created_by"`
+	UpdatedBy      string  `json:"updated_by"`
+	CreatedAt      string  `json:"created_at"` // Expected format: date-time
+	UpdatedAt      string  `json:"updated_at"` // Expected format: date-time
+	Owner          string  `json:"owner"`
+	DocumentsCount int     `json:"documents_count"`
+}
@@ -0,0 +1,13 @@
+// Copyright (c) Trifork
+
+package coraxclient
+
+import "encoding/json"
+
+// PolicyApplicationResponse is the response of applying a compliance policy to all non-compliant capabilities in its scope.
+// The API does not document the response schema, so only the summary message is decoded and the raw body is kept as is.
+// Corresponds to the response of POST /v1/compliance/policies/{policy_id}/applications.
+type PolicyApplicationResponse struct {
+	Message string          `json:"message,omitempty"`
+	Raw     json.RawMessage `json:"-"`
+}
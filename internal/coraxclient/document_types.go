@@ -0,0 +1,12 @@
+// Copyright (c) Trifork
+
+package coraxclient
+
+// DocumentUpload represents a single file to embed into a knowledge collection.
+// Corresponds to one entry of the multipart "files" field of POST /v1/collections/{collection_id}/embed.
+type DocumentUpload struct {
+	Filename         string
+	Content          []byte
+	Language         *string           // Sent as an additional form field; may be ignored by the API
+	DocumentMetadata map[string]string // Sent as a JSON encoded form field; may be ignored by the API
+}
@@ -0,0 +1,16 @@
+// Copyright (c) Trifork
+
+package coraxclient
+
+import "time"
+
+// GuardrailEventFilter holds the optional filters of GET /v1/compliance/guardrail-events.
+// Empty strings and nil times are not sent.
+type GuardrailEventFilter struct {
+	CapabilityID string
+	PolicyID     string
+	ControlType  string // One of components.schemas.ScanCategory
+	Direction    string // One of components.schemas.ScanDirection
+	FromDate     *time.Time
+	ToDate       *time.Time
+}
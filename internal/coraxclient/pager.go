@@ -0,0 +1,26 @@
+// Copyright (c) Trifork
+
+package coraxclient
+
+// listPageSize is the page size used when reading every page of a paged list endpoint.
+// It is the maximum size the API accepts.
+const listPageSize = 100
+
+// listAllPages reads every page of a paged list endpoint. fetch is called for page 1, 2, ... with
+// listPageSize and returns the items of the page and the total number of pages; reading stops after
+// the last page or at the first empty page.
+func listAllPages[T any](fetch func(page int32, size int32) ([]T, int32, error)) ([]T, error) {
+	var items []T
+	for page := int32(1); ; page++ {
+		embedded, totalPages, err := fetch(page, listPageSize)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, embedded...)
+		if len(embedded) == 0 || page >= totalPages {
+			break
+		}
+	}
+	return items, nil
+}
@@ -629,3 +629,16 @@ func convertInterfaceToAttrValue(val interface{}) (attr.Value, *diag.Diagnostics
 		return nil, &diags
 	}
 }
+
+// capabilityModelRoutingAPIToModel maps the model routing fields returned by the API.
+// When a capability is routed through a model pool the API may also report the
+// deployment it resolved to, so model_id is only populated when no pool is set.
+func capabilityModelRoutingAPIToModel(modelID *string, modelPoolID *string) (types.String, types.String) {
+	if modelPoolID != nil && *modelPoolID != "" {
+		return types.StringNull(), types.StringValue(*modelPoolID)
+	}
+	if modelID != nil {
+		return types.StringValue(*modelID), types.StringNull()
+	}
+	return types.StringNull(), types.StringNull()
+}
@@ -496,6 +496,56 @@ func TestCustomParametersAPIToTerraform(t *testing.T) {
 	}
 }
 
+func TestCapabilityModelRoutingAPIToModel(t *testing.T) {
+	modelID := "11111111-1111-1111-1111-111111111111"
+	poolID := "22222222-2222-2222-2222-222222222222"
+
+	tests := []struct {
+		name          string
+		modelID       *string
+		modelPoolID   *string
+		expectedModel types.String
+		expectedPool  types.String
+	}{
+		{
+			name:          "neither set",
+			expectedModel: types.StringNull(),
+			expectedPool:  types.StringNull(),
+		},
+		{
+			name:          "model deployment only",
+			modelID:       &modelID,
+			expectedModel: types.StringValue(modelID),
+			expectedPool:  types.StringNull(),
+		},
+		{
+			name:          "model pool only",
+			modelPoolID:   &poolID,
+			expectedModel: types.StringNull(),
+			expectedPool:  types.StringValue(poolID),
+		},
+		{
+			name:          "pool with resolved deployment ignores deployment",
+			modelID:       &modelID,
+			modelPoolID:   &poolID,
+			expectedModel: types.StringNull(),
+			expectedPool:  types.StringValue(poolID),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotModel, gotPool := capabilityModelRoutingAPIToModel(tt.modelID, tt.modelPoolID)
+			if !gotModel.Equal(tt.expectedModel) {
+				t.Errorf("Expected model_id %s, got %s", tt.expectedModel, gotModel)
+			}
+			if !gotPool.Equal(tt.expectedPool) {
+				t.Errorf("Expected model_pool_id %s, got %s", tt.expectedPool, gotPool)
+			}
+		})
+	}
+}
+
 func contains(s, substr string) bool {
 	return len(s) >= len(substr) && (s == substr || len(s) > len(substr) && containsSubstring(s, substr))
 }
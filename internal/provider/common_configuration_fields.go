@@ -0,0 +1,252 @@
+// Copyright (c) Trifork
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	api "terraform-provider-corax/internal/generated"
+)
+
+// unknownConfigurationValue stands for a configuration value that isn't known until apply. The field
+// counts as set, but its value isn't checked.
+type unknownConfigurationValue struct{}
+
+// validateConfigurationFields checks a configuration object against the ConfigurationField
+// definitions published by the API (for example by criterion types). Problems are reported
+// as attribute errors on attrPath.
+func validateConfigurationFields(fields []api.ConfigurationField, config map[string]interface{}, attrPath path.Path, diags *diag.Diagnostics) {
+	validateConfigurationFieldsAt(fields, config, attrPath, func(string) path.Path { return attrPath }, diags)
+}
+
+// validateConfigurationFieldsAt is validateConfigurationFields with problems with a single key
+// reported on keyPath(key). Missing fields are reported on attrPath.
+func validateConfigurationFieldsAt(fields []api.ConfigurationField, config map[string]interface{}, attrPath path.Path, keyPath func(string) path.Path, diags *diag.Diagnostics) {
+	known := make(map[string]api.ConfigurationField, len(fields))
+	names := make([]string, 0, len(fields))
+	for _, field := range fields {
+		known[field.Name] = field
+		names = append(names, field.Name)
+	}
+	sort.Strings(names)
+
+	keys := make([]string, 0, len(config))
+	for key := range config {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		field, ok := known[key]
+		if !ok {
+			supported := "none"
+			if len(names) > 0 {
+				supported = strings.Join(names, ", ")
+			}
+			diags.AddAttributeError(keyPath(key), "Unsupported Configuration Field",
+				fmt.Sprintf("%q is not a supported configuration field. Supported fields: %s.", key, supported))
+			continue
+		}
+		if err := validateConfigurationFieldValue(field, config[key]); err != nil {
+			diags.AddAttributeError(keyPath(key), "Invalid Configuration Field",
+				fmt.Sprintf("Invalid value for %q: %s.", key, err))
+		}
+	}
+
+	for _, name := range names {
+		field := known[name]
+		if _, ok := config[name]; ok || !field.Required {
+			continue
+		}
+		if def, ok := field.GetDefaultOk(); ok && def != nil {
+			continue
+		}
+		diags.AddAttributeError(attrPath, "Missing Configuration Field",
+			fmt.Sprintf("The configuration field %q (%s) is required.", name, field.Label))
+	}
+}
+
+// validateStringConfigurationFields checks a map(string) configuration attribute, such as
+// corax_model_provider.configuration, against the ConfigurationField definitions published by the API.
+// Values are parsed according to their field type first: numbers and booleans as in Go, lists as a
+// JSON array or comma-separated. Problems with a single key are reported on that key of attrPath.
+func validateStringConfigurationFields(fields []api.ConfigurationField, config types.Map, attrPath path.Path, diags *diag.Diagnostics) {
+	if config.IsNull() || config.IsUnknown() {
+		return
+	}
+
+	known := make(map[string]api.ConfigurationField, len(fields))
+	for _, field := range fields {
+		known[field.Name] = field
+	}
+
+	elements := config.Elements()
+	keys := make([]string, 0, len(elements))
+	for key := range elements {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	values := make(map[string]interface{}, len(elements))
+	for _, key := range keys {
+		element := elements[key]
+		switch {
+		case element.IsNull():
+			continue
+		case element.IsUnknown():
+			values[key] = unknownConfigurationValue{}
+			continue
+		}
+		s, ok := element.(types.String)
+		if !ok {
+			continue
+		}
+		value, err := parseConfigurationString(known[key], s.ValueString())
+		if err != nil {
+			diags.AddAttributeError(attrPath.AtMapKey(key), "Invalid Configuration Field",
+				fmt.Sprintf("Invalid value for %q: %s.", key, err))
+			value = unknownConfigurationValue{}
+		}
+		values[key] = value
+	}
+
+	validateConfigurationFieldsAt(fields, values, attrPath, attrPath.AtMapKey, diags)
+}
+
+// parseConfigurationString converts a configuration value given as a string to the JSON value
+// expected by its field type. Values of unknown fields are returned as is. As with
+// validateConfigurationFieldValue, errors never include the value.
+func parseConfigurationString(field api.ConfigurationField, s string) (interface{}, error) {
+	switch field.Type {
+	case api.FIELD_TYPE_NUMBER:
+		n, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+		if err != nil {
+			return nil, fmt.Errorf("expected a number")
+		}
+		return n, nil
+	case api.FIELD_TYPE_BOOLEAN:
+		b, err := strconv.ParseBool(strings.TrimSpace(s))
+		if err != nil {
+			return nil, fmt.Errorf("expected a boolean (true or false)")
+		}
+		return b, nil
+	case api.FIELD_TYPE_STRING_ARRAY:
+		var items []interface{}
+		if strings.HasPrefix(strings.TrimSpace(s), "[") {
+			if err := json.Unmarshal([]byte(s), &items); err != nil {
+				return nil, fmt.Errorf("expected a JSON array or a comma-separated list")
+			}
+			return items, nil
+		}
+		for _, item := range strings.Split(s, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+		return items, nil
+	}
+	return s, nil
+}
+
+// validateConfigurationFieldValue checks a single value against its field definition.
+// Errors describe the expected type or format but never include the value, which may be a secret.
+func validateConfigurationFieldValue(field api.ConfigurationField, value interface{}) error {
+	if _, ok := value.(unknownConfigurationValue); ok {
+		return nil
+	}
+	switch field.Type {
+	case api.FIELD_TYPE_TEXT, api.FIELD_TYPE_KEY, api.FIELD_TYPE_URL:
+		s, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("expected a string, got %s", describeJSONValue(value))
+		}
+		if field.Type == api.FIELD_TYPE_URL {
+			if u, err := url.ParseRequestURI(s); err != nil || u.Scheme == "" || u.Host == "" {
+				return fmt.Errorf("expected an absolute URL with a scheme and host")
+			}
+		}
+		if pattern, ok := field.GetPatternOk(); ok && pattern != nil && *pattern != "" {
+			re, err := regexp.Compile(*pattern)
+			if err == nil && !re.MatchString(s) {
+				return fmt.Errorf("value does not match the pattern %s", *pattern)
+			}
+		}
+	case api.FIELD_TYPE_NUMBER:
+		n, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("expected a number, got %s", describeJSONValue(value))
+		}
+		if min, ok := field.GetMinOk(); ok && min != nil {
+			if bound, set := configurationFieldBound(min.Float32, min.Int32); set && n < bound {
+				return fmt.Errorf("must be at least %v", bound)
+			}
+		}
+		if max, ok := field.GetMaxOk(); ok && max != nil {
+			if bound, set := configurationFieldBound(max.Float32, max.Int32); set && n > bound {
+				return fmt.Errorf("must be at most %v", bound)
+			}
+		}
+	case api.FIELD_TYPE_BOOLEAN:
+		if _, ok := value.(bool); !ok {
+			return fmt.Errorf("expected a boolean, got %s", describeJSONValue(value))
+		}
+	case api.FIELD_TYPE_STRING_ARRAY:
+		items, ok := value.([]interface{})
+		if !ok {
+			return fmt.Errorf("expected a list of strings, got %s", describeJSONValue(value))
+		}
+		for i, item := range items {
+			if _, ok := item.(string); !ok {
+				return fmt.Errorf("expected a list of strings, element %d is %s", i, describeJSONValue(item))
+			}
+		}
+	}
+	return nil
+}
+
+// float32ToFloat64 converts a float32 sent by the API through its shortest decimal form, so a value configured as 0.1
+// reads back as 0.1 instead of 0.10000000149011612, without dropping any of the digits the float32 holds.
+func float32ToFloat64(f float32) float64 {
+	value, _ := strconv.ParseFloat(strconv.FormatFloat(float64(f), 'g', -1, 32), 64)
+	return value
+}
+
+// configurationFieldBound returns the numeric value of a min/max bound, which the API sends as either a float or an int.
+func configurationFieldBound(f *float32, i *int32) (float64, bool) {
+	switch {
+	case f != nil:
+		return float32ToFloat64(*f), true
+	case i != nil:
+		return float64(*i), true
+	}
+	return 0, false
+}
+
+// describeJSONValue names the JSON type of a decoded value for error messages.
+func describeJSONValue(value interface{}) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case string:
+		return "a string"
+	case float64:
+		return "a number"
+	case bool:
+		return "a boolean"
+	case []interface{}:
+		return "a list"
+	case map[string]interface{}:
+		return "an object"
+	}
+	return fmt.Sprintf("%T", value)
+}
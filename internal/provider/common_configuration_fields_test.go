@@ -0,0 +1,273 @@
+// Copyright (c) Trifork
+
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	api "terraform-provider-corax/internal/generated"
+)
+
+func TestValidateConfigurationFields(t *testing.T) {
+	threshold := api.NewConfigurationField("threshold", "Threshold", api.FIELD_TYPE_NUMBER, true)
+	minValue, maxValue := float32(0), float32(1)
+	threshold.SetMin(api.Min{Float32: &minValue})
+	threshold.SetMax(api.Max{Float32: &maxValue})
+
+	criteria := api.NewConfigurationField("criteria", "Criteria", api.FIELD_TYPE_TEXT, true)
+	strict := api.NewConfigurationField("strict_mode", "Strict Mode", api.FIELD_TYPE_BOOLEAN, false)
+	params := api.NewConfigurationField("evaluation_params", "Evaluation Params", api.FIELD_TYPE_STRING_ARRAY, false)
+	endpoint := api.NewConfigurationField("endpoint", "Endpoint", api.FIELD_TYPE_URL, false)
+
+	fields := []api.ConfigurationField{*threshold, *criteria, *strict, *params, *endpoint}
+
+	tests := []struct {
+		name           string
+		config         map[string]interface{}
+		expectedErrors []string
+	}{
+		{
+			name: "valid configuration",
+			config: map[string]interface{}{
+				"threshold":         0.5,
+				"criteria":          "Is the answer correct?",
+				"strict_mode":       true,
+				"evaluation_params": []interface{}{"input", "actual_output"},
+				"endpoint":          "https://example.com/score",
+			},
+		},
+		{
+			name:           "missing required fields",
+			config:         map[string]interface{}{},
+			expectedErrors: []string{`"criteria" (Criteria) is required`, `"threshold" (Threshold) is required`},
+		},
+		{
+			name: "unsupported field",
+			config: map[string]interface{}{
+				"threshold": 0.5,
+				"criteria":  "x",
+				"model":     "gpt-4o",
+			},
+			expectedErrors: []string{`"model" is not a supported configuration field. Supported fields: criteria, endpoint, evaluation_params, strict_mode, threshold.`},
+		},
+		{
+			name: "wrong types",
+			config: map[string]interface{}{
+				"threshold":         "0.5",
+				"criteria":          12.0,
+				"strict_mode":       "yes",
+				"evaluation_params": []interface{}{"input", 1.0},
+			},
+			expectedErrors: []string{
+				`Invalid value for "criteria": expected a string, got a number.`,
+				`Invalid value for "evaluation_params": expected a list of strings, element 1 is a number.`,
+				`Invalid value for "strict_mode": expected a boolean, got a string.`,
+				`Invalid value for "threshold": expected a number, got a string.`,
+			},
+		},
+		{
+			name: "out of range number",
+			config: map[string]interface{}{
+				"threshold": 1.5,
+				"criteria":  "x",
+			},
+			expectedErrors: []string{`Invalid value for "threshold": must be at most 1.`},
+		},
+		{
+			name: "relative url",
+			config: map[string]interface{}{
+				"threshold": 0.5,
+				"criteria":  "x",
+				"endpoint":  "/score",
+			},
+			expectedErrors: []string{`Invalid value for "endpoint": expected an absolute URL with a scheme and host.`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			validateConfigurationFields(fields, tt.config, path.Root("configuration"), &diags)
+
+			if len(diags) != len(tt.expectedErrors) {
+				t.Fatalf("Expected %d diagnostics, got %d: %v", len(tt.expectedErrors), len(diags), diags)
+			}
+			for i, expected := range tt.expectedErrors {
+				if !strings.Contains(diags[i].Detail(), expected) {
+					t.Errorf("Expected diagnostic %d to contain %q, got %q", i, expected, diags[i].Detail())
+				}
+			}
+		})
+	}
+}
+
+func TestValidateStringConfigurationFields(t *testing.T) {
+	apiKey := api.NewConfigurationField("api_key", "API Key", api.FIELD_TYPE_KEY, true)
+	baseURL := api.NewConfigurationField("base_url", "Base URL", api.FIELD_TYPE_URL, false)
+	modelName := api.NewConfigurationField("model_name", "Model Name", api.FIELD_TYPE_TEXT, true)
+	modelName.SetDefault(api.Default{String: api.PtrString("gpt-4o")})
+	temperature := api.NewConfigurationField("temperature", "Temperature", api.FIELD_TYPE_NUMBER, false)
+	maxTemperature := float32(2)
+	temperature.SetMax(api.Max{Float32: &maxTemperature})
+	stream := api.NewConfigurationField("stream", "Stream", api.FIELD_TYPE_BOOLEAN, false)
+	stop := api.NewConfigurationField("stop", "Stop Sequences", api.FIELD_TYPE_STRING_ARRAY, false)
+
+	fields := []api.ConfigurationField{*apiKey, *baseURL, *modelName, *temperature, *stream, *stop}
+	configurationPath := path.Root("configuration")
+
+	tests := []struct {
+		name           string
+		config         map[string]attr.Value
+		expectedPaths  []path.Path
+		expectedErrors []string
+	}{
+		{
+			name: "valid configuration",
+			config: map[string]attr.Value{
+				"api_key":     types.StringValue("sk-123"),
+				"base_url":    types.StringValue("https://api.openai.com/v1"),
+				"temperature": types.StringValue("0.7"),
+				"stream":      types.StringValue("true"),
+				"stop":        types.StringValue(`["END", "STOP"]`),
+			},
+		},
+		{
+			name: "comma-separated list",
+			config: map[string]attr.Value{
+				"api_key": types.StringValue("sk-123"),
+				"stop":    types.StringValue("END, STOP"),
+			},
+		},
+		{
+			name: "unknown values are not checked",
+			config: map[string]attr.Value{
+				"api_key":  types.StringUnknown(),
+				"base_url": types.StringUnknown(),
+			},
+		},
+		{
+			name: "null value counts as missing",
+			config: map[string]attr.Value{
+				"api_key": types.StringNull(),
+			},
+			expectedPaths:  []path.Path{configurationPath},
+			expectedErrors: []string{`"api_key" (API Key) is required`},
+		},
+		{
+			name: "misspelled key",
+			config: map[string]attr.Value{
+				"api_key": types.StringValue("sk-123"),
+				"baseurl": types.StringValue("https://api.openai.com/v1"),
+			},
+			expectedPaths:  []path.Path{configurationPath.AtMapKey("baseurl")},
+			expectedErrors: []string{`"baseurl" is not a supported configuration field. Supported fields: api_key, base_url, model_name, stop, stream, temperature.`},
+		},
+		{
+			name: "wrong formats",
+			config: map[string]attr.Value{
+				"api_key":     types.StringValue("sk-123"),
+				"base_url":    types.StringValue("api.openai.com"),
+				"temperature": types.StringValue("abc"),
+				"stream":      types.StringValue("yes"),
+				"stop":        types.StringValue(`["END"`),
+			},
+			expectedPaths: []path.Path{
+				configurationPath.AtMapKey("stop"),
+				configurationPath.AtMapKey("stream"),
+				configurationPath.AtMapKey("temperature"),
+				configurationPath.AtMapKey("base_url"),
+			},
+			expectedErrors: []string{
+				`Invalid value for "stop": expected a JSON array or a comma-separated list.`,
+				`Invalid value for "stream": expected a boolean (true or false).`,
+				`Invalid value for "temperature": expected a number.`,
+				`Invalid value for "base_url": expected an absolute URL with a scheme and host.`,
+			},
+		},
+		{
+			name: "out of range number",
+			config: map[string]attr.Value{
+				"api_key":     types.StringValue("sk-123"),
+				"temperature": types.StringValue("2.5"),
+			},
+			expectedPaths:  []path.Path{configurationPath.AtMapKey("temperature")},
+			expectedErrors: []string{`Invalid value for "temperature": must be at most 2.`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			validateStringConfigurationFields(fields, types.MapValueMust(types.StringType, tt.config), configurationPath, &diags)
+
+			if len(diags) != len(tt.expectedErrors) {
+				t.Fatalf("Expected %d diagnostics, got %d: %v", len(tt.expectedErrors), len(diags), diags)
+			}
+			for i, expected := range tt.expectedErrors {
+				if !strings.Contains(diags[i].Detail(), expected) {
+					t.Errorf("Expected diagnostic %d to contain %q, got %q", i, expected, diags[i].Detail())
+				}
+				withPath, ok := diags[i].(diag.DiagnosticWithPath)
+				if !ok || !withPath.Path().Equal(tt.expectedPaths[i]) {
+					t.Errorf("Expected diagnostic %d on %s, got %v", i, tt.expectedPaths[i], diags[i])
+				}
+			}
+		})
+	}
+}
+
+func TestValidateStringConfigurationFieldsHidesValues(t *testing.T) {
+	baseURL := api.NewConfigurationField("base_url", "Base URL", api.FIELD_TYPE_URL, false)
+	temperature := api.NewConfigurationField("temperature", "Temperature", api.FIELD_TYPE_NUMBER, false)
+	stream := api.NewConfigurationField("stream", "Stream", api.FIELD_TYPE_BOOLEAN, false)
+	stop := api.NewConfigurationField("stop", "Stop Sequences", api.FIELD_TYPE_STRING_ARRAY, false)
+	fields := []api.ConfigurationField{*baseURL, *temperature, *stream, *stop}
+
+	// Configuration is sensitive, a value put in the wrong field must not end up in plan output.
+	secret := "sk-secret-123"
+	config := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"base_url":    types.StringValue(secret),
+		"temperature": types.StringValue(secret),
+		"stream":      types.StringValue(secret),
+		"stop":        types.StringValue("[" + secret),
+	})
+
+	var diags diag.Diagnostics
+	validateStringConfigurationFields(fields, config, path.Root("configuration"), &diags)
+	if diags.ErrorsCount() != 4 {
+		t.Fatalf("Expected 4 errors, got %v", diags)
+	}
+	for _, d := range diags {
+		if strings.Contains(d.Summary(), secret) || strings.Contains(d.Detail(), secret) {
+			t.Errorf("Expected diagnostic not to contain the configured value, got %q", d.Detail())
+		}
+	}
+}
+
+func TestConfigurationFieldBound(t *testing.T) {
+	f := float32(0.1)
+	if bound, ok := configurationFieldBound(&f, nil); !ok || bound != 0.1 {
+		t.Errorf("Expected float bound 0.1, got %v (set: %v)", bound, ok)
+	}
+	i := int32(5)
+	if bound, ok := configurationFieldBound(nil, &i); !ok || bound != 5 {
+		t.Errorf("Expected int bound 5, got %v (set: %v)", bound, ok)
+	}
+	if _, ok := configurationFieldBound(nil, nil); ok {
+		t.Error("Expected unset bound")
+	}
+}
+
+func TestFloat32ToFloat64(t *testing.T) {
+	for _, want := range []float64{0, 0.1, 0.8, 0.12345, 0.99996, 1} {
+		if got := float32ToFloat64(float32(want)); got != want {
+			t.Errorf("Expected %v, got %v", want, got)
+		}
+	}
+}
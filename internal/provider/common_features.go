@@ -0,0 +1,22 @@
+// Copyright (c) Trifork
+
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	"terraform-provider-corax/internal/coraxclient"
+)
+
+// requireFeature adds an error when the provider preflight discovered that the server has the
+// feature disabled. Without preflight, or when the server does not report the feature, nothing is checked.
+func requireFeature(client *coraxclient.Client, feature string, typeName string, diags *diag.Diagnostics) {
+	if client == nil || !client.FeatureDisabled(feature) {
+		return
+	}
+	diags.AddError("Feature Disabled",
+		fmt.Sprintf("%s requires the %q feature, which is disabled on the Corax server. "+
+			"Enable the feature on the server or remove %s from the configuration.", typeName, feature, typeName))
+}
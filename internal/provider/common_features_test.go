@@ -0,0 +1,55 @@
+// Copyright (c) Trifork
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	"terraform-provider-corax/internal/coraxclient"
+)
+
+func TestRequireFeature(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v1/auth/me":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"user_id": "user-1", "username": "jane", "auth_source": "api_key"})
+		case "/features":
+			_ = json.NewEncoder(w).Encode(map[string]bool{coraxclient.FeatureCompliance: false})
+		default:
+			_ = json.NewEncoder(w).Encode(map[string]string{"status": "ok"})
+		}
+	}))
+	defer server.Close()
+
+	client, err := coraxclient.NewClient(server.URL, "test-api-key")
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	var diags diag.Diagnostics
+	requireFeature(client, coraxclient.FeatureCompliance, "corax_compliance_policy", &diags)
+	if diags.HasError() {
+		t.Errorf("Expected no error before features are discovered, got %v", diags)
+	}
+
+	if _, err := client.Preflight(context.Background()); err != nil {
+		t.Fatalf("Unexpected preflight error: %v", err)
+	}
+
+	requireFeature(client, coraxclient.FeatureMCPServers, "corax_mcp_server", &diags)
+	if diags.HasError() {
+		t.Errorf("Expected no error for a feature the server does not report, got %v", diags)
+	}
+
+	requireFeature(client, coraxclient.FeatureCompliance, "corax_compliance_policy", &diags)
+	if diags.ErrorsCount() != 1 || diags.Errors()[0].Summary() != "Feature Disabled" {
+		t.Errorf("Expected a Feature Disabled error, got %v", diags)
+	}
+}
@@ -0,0 +1,324 @@
+// Copyright (c) Trifork
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-corax/internal/coraxclient"
+	api "terraform-provider-corax/internal/generated"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CapabilitiesDataSource{}
+
+func NewCapabilitiesDataSource() datasource.DataSource {
+	return &CapabilitiesDataSource{}
+}
+
+// CapabilitiesDataSource defines the data source implementation.
+type CapabilitiesDataSource struct {
+	client *coraxclient.Client
+}
+
+// CapabilitiesDataSourceModel describes the data source data model.
+type CapabilitiesDataSourceModel struct {
+	Type                  types.String `tfsdk:"type"`                    // Optional filter
+	ProjectID             types.String `tfsdk:"project_id"`              // Optional filter
+	Owner                 types.String `tfsdk:"owner"`                   // Optional filter
+	IncludeDefaultVersion types.Bool   `tfsdk:"include_default_version"` // Optional, defaults to false
+	Capabilities          types.List   `tfsdk:"capabilities"`            // List of CapabilityModel
+}
+
+// CapabilityModel maps to components.schemas.Capability.
+// It is also embedded in the data model of the corax_capability data source.
+type CapabilityModel struct {
+	ID             types.String `tfsdk:"id"`
+	SemanticID     types.String `tfsdk:"semantic_id"`
+	Name           types.String `tfsdk:"name"`
+	Type           types.String `tfsdk:"type"`
+	IsPublic       types.Bool   `tfsdk:"is_public"`     // Nullable
+	ModelID        types.String `tfsdk:"model_id"`      // Nullable
+	ModelPoolID    types.String `tfsdk:"model_pool_id"` // Nullable
+	ProjectID      types.String `tfsdk:"project_id"`    // Nullable
+	Owner          types.String `tfsdk:"owner"`
+	Version        types.Int64  `tfsdk:"version"`         // Nullable
+	DefaultVersion types.Int64  `tfsdk:"default_version"` // Nullable, from the versions endpoint
+	CreatedBy      types.String `tfsdk:"created_by"`
+	UpdatedBy      types.String `tfsdk:"updated_by"`
+	CreatedAt      types.String `tfsdk:"created_at"`  // RFC3339
+	UpdatedAt      types.String `tfsdk:"updated_at"`  // RFC3339
+	ArchivedAt     types.String `tfsdk:"archived_at"` // Nullable, RFC3339
+}
+
+func capabilityAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":              types.StringType,
+		"semantic_id":     types.StringType,
+		"name":            types.StringType,
+		"type":            types.StringType,
+		"is_public":       types.BoolType,
+		"model_id":        types.StringType,
+		"model_pool_id":   types.StringType,
+		"project_id":      types.StringType,
+		"owner":           types.StringType,
+		"version":         types.Int64Type,
+		"default_version": types.Int64Type,
+		"created_by":      types.StringType,
+		"updated_by":      types.StringType,
+		"created_at":      types.StringType,
+		"updated_at":      types.StringType,
+		"archived_at":     types.StringType,
+	}
+}
+
+// capabilitySchemaAttributes returns the computed attributes of a capability, shared by corax_capabilities and corax_capability.
+func capabilitySchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The UUID of the capability.",
+		},
+		"semantic_id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The semantic ID of the capability, which services use to address it.",
+		},
+		"name": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The name of the capability.",
+		},
+		"type": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The type of the capability, e.g. `chat` or `completion`.",
+		},
+		"is_public": schema.BoolAttribute{
+			Computed:            true,
+			MarkdownDescription: "Whether the capability is public, if known.",
+		},
+		"model_id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The UUID of the model deployment the capability uses, if set.",
+		},
+		"model_pool_id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The UUID of the model pool the capability uses, if set.",
+		},
+		"project_id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The UUID of the project the capability belongs to, if any.",
+		},
+		"owner": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The owner of the capability.",
+		},
+		"version": schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: "The version of the capability that was returned, if reported.",
+		},
+		"default_version": schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: "The current default version of the capability, if one is set. `corax_capabilities` only reports it with `include_default_version`.",
+		},
+		"created_by": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The ID of the user who created the capability.",
+		},
+		"updated_by": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The ID of the user who last updated the capability.",
+		},
+		"created_at": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The date and time the capability was created (RFC3339 format).",
+		},
+		"updated_at": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The date and time the capability was last updated (RFC3339 format).",
+		},
+		"archived_at": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The date and time the capability was archived (RFC3339 format), if archived.",
+		},
+	}
+}
+
+// optionalInt32Value converts an optional API integer to a types.Int64, or null when absent.
+func optionalInt32Value(v *int32) types.Int64 {
+	if v == nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(*v))
+}
+
+// defaultCapabilityVersion returns the number of the default version, or null when no version is the default.
+func defaultCapabilityVersion(versions []api.CapabilityVersion) types.Int64 {
+	for _, v := range versions {
+		if v.IsDefaultVersion {
+			return types.Int64Value(int64(v.Version))
+		}
+	}
+	return types.Int64Null()
+}
+
+// mapCapabilityToModel maps an api.Capability to the Terraform model. DefaultVersion is left null.
+func mapCapabilityToModel(capability *api.Capability) CapabilityModel {
+	return CapabilityModel{
+		ID:             types.StringValue(capability.Id),
+		SemanticID:     types.StringValue(capability.SemanticId),
+		Name:           types.StringValue(capability.Name),
+		Type:           types.StringValue(capability.Type),
+		IsPublic:       types.BoolPointerValue(capability.IsPublic.Get()),
+		ModelID:        types.StringPointerValue(capability.ModelId.Get()),
+		ModelPoolID:    types.StringPointerValue(capability.ModelPoolId.Get()),
+		ProjectID:      types.StringPointerValue(capability.ProjectId.Get()),
+		Owner:          types.StringValue(capability.Owner),
+		Version:        optionalInt32Value(capability.Version),
+		DefaultVersion: types.Int64Null(),
+		CreatedBy:      types.StringValue(capability.CreatedBy),
+		UpdatedBy:      types.StringValue(capability.UpdatedBy),
+		CreatedAt:      types.StringValue(capability.CreatedAt.Format(time.RFC3339)),
+		UpdatedAt:      types.StringValue(capability.UpdatedAt.Format(time.RFC3339)),
+		ArchivedAt:     optionalTimestampValue(capability.ArchivedAt.Get()),
+	}
+}
+
+// capabilitiesListFilter builds the API filter for the non-empty filters, as field::value conditions separated by |.
+func capabilitiesListFilter(capabilityType string, projectID string, owner string) string {
+	var conditions []string
+	if capabilityType != "" {
+		conditions = append(conditions, "type::"+capabilityType)
+	}
+	if projectID != "" {
+		conditions = append(conditions, "project_id::"+projectID)
+	}
+	if owner != "" {
+		conditions = append(conditions, "owner::"+owner)
+	}
+	return strings.Join(conditions, "|")
+}
+
+// filterCapabilities returns the capabilities matching every non-empty filter exactly.
+// The filters are also sent to the API, this guards against looser matching on the server.
+func filterCapabilities(capabilities []api.Capability, capabilityType string, projectID string, owner string) []api.Capability {
+	matches := []api.Capability{}
+	for _, capability := range capabilities {
+		if capabilityType != "" && capability.Type != capabilityType {
+			continue
+		}
+		if projectID != "" && capability.GetProjectId() != projectID {
+			continue
+		}
+		if owner != "" && capability.Owner != owner {
+			continue
+		}
+		matches = append(matches, capability)
+	}
+	return matches
+}
+
+func (d *CapabilitiesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_capabilities"
+}
+
+func (d *CapabilitiesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the Corax capabilities of every type visible to the caller, optionally filtered by type, project and owner.",
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only list capabilities of this type, e.g. `chat`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(enumStrings(api.AllowedCapabilityTypeEnumValues)...),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only list capabilities in the project with this UUID.",
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"owner": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only list capabilities with this owner.",
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"include_default_version": schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: "Whether to look up `default_version` for every capability, which takes one extra request per capability. " +
+					"Defaults to `false`, which leaves `default_version` null.",
+			},
+			"capabilities": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The capabilities matching the filters.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: capabilitySchemaAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *CapabilitiesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*coraxclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *coraxclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *CapabilitiesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CapabilitiesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Listing capabilities")
+	capabilities, err := d.client.ListCapabilities(ctx, capabilitiesListFilter(data.Type.ValueString(), data.ProjectID.ValueString(), data.Owner.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list capabilities, got error: %s", err))
+		return
+	}
+	capabilities = filterCapabilities(capabilities, data.Type.ValueString(), data.ProjectID.ValueString(), data.Owner.ValueString())
+
+	models := make([]CapabilityModel, 0, len(capabilities))
+	for i := range capabilities {
+		model := mapCapabilityToModel(&capabilities[i])
+
+		if data.IncludeDefaultVersion.ValueBool() {
+			versions, err := d.client.ListCapabilityVersions(ctx, capabilities[i].Id)
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list versions of capability %s, got error: %s", capabilities[i].Id, err))
+				return
+			}
+			model.DefaultVersion = defaultCapabilityVersion(versions)
+		}
+
+		models = append(models, model)
+	}
+	capabilitiesVal, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: capabilityAttributeTypes()}, models)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Capabilities = capabilitiesVal
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,164 @@
+// Copyright (c) Trifork
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	api "terraform-provider-corax/internal/generated"
+)
+
+func TestAccCapabilitiesDataSource_basic(t *testing.T) {
+	if os.Getenv("CORAX_API_ENDPOINT") == "" || os.Getenv("CORAX_API_KEY") == "" {
+		t.Skip("Skipping acceptance test: CORAX_API_ENDPOINT or CORAX_API_KEY not set")
+	}
+
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	name := fmt.Sprintf("tf-acc-test-capabilities-%s", rName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCapabilitiesDataSourceConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.corax_capability.by_semantic_id", "id", "corax_chat_capability.test", "id"),
+					resource.TestCheckResourceAttr("data.corax_capability.by_semantic_id", "type", "chat"),
+					resource.TestCheckResourceAttr("data.corax_capability.by_semantic_id", "system_prompt", "You are a helpful assistant."),
+					resource.TestCheckResourceAttrSet("data.corax_capability.by_semantic_id", "default_version"),
+					resource.TestCheckResourceAttrPair("data.corax_capability.by_id", "semantic_id", "corax_chat_capability.test", "semantic_id"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.corax_capabilities.test", "capabilities.*", map[string]string{"name": name, "type": "chat"}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCapabilitiesDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+provider "corax" {}
+
+resource "corax_chat_capability" "test" {
+  name          = %[1]q
+  semantic_id   = %[1]q
+  system_prompt = "You are a helpful assistant."
+}
+
+data "corax_capabilities" "test" {
+  type       = "chat"
+  depends_on = [corax_chat_capability.test]
+}
+
+data "corax_capability" "by_semantic_id" {
+  semantic_id = corax_chat_capability.test.semantic_id
+}
+
+data "corax_capability" "by_id" {
+  id = corax_chat_capability.test.id
+}
+`, name)
+}
+
+func TestFilterCapabilities(t *testing.T) {
+	capabilities := []api.Capability{
+		{Id: "cap-1", Type: "chat", Owner: "alice", ProjectId: *api.NewNullableString(api.PtrString("project-1"))},
+		{Id: "cap-2", Type: "completion", Owner: "alice"},
+		{Id: "cap-3", Type: "chat", Owner: "bob"},
+	}
+
+	tests := []struct {
+		name           string
+		capabilityType string
+		projectID      string
+		owner          string
+		want           []string
+	}{
+		{name: "no filters", want: []string{"cap-1", "cap-2", "cap-3"}},
+		{name: "type", capabilityType: "chat", want: []string{"cap-1", "cap-3"}},
+		{name: "project", projectID: "project-1", want: []string{"cap-1"}},
+		{name: "type and owner", capabilityType: "chat", owner: "bob", want: []string{"cap-3"}},
+		{name: "no match", owner: "carol", want: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := []string{}
+			for _, capability := range filterCapabilities(capabilities, tt.capabilityType, tt.projectID, tt.owner) {
+				got = append(got, capability.Id)
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("filterCapabilities() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDefaultCapabilityVersion(t *testing.T) {
+	versions := []api.CapabilityVersion{{Version: 1}, {Version: 2, IsDefaultVersion: true}, {Version: 3}}
+	if got := defaultCapabilityVersion(versions); got.ValueInt64() != 2 {
+		t.Errorf("defaultCapabilityVersion() = %v, want 2", got)
+	}
+	if got := defaultCapabilityVersion(versions[:1]); !got.IsNull() {
+		t.Errorf("defaultCapabilityVersion() = %v, want null", got)
+	}
+}
+
+func TestMapCapabilityRepresentationToModel(t *testing.T) {
+	capability := &api.CapabilityRepresentation{
+		Id:         "cap-1",
+		Name:       "Summarizer",
+		Type:       "completion",
+		SemanticId: "summarizer",
+		Owner:      "alice",
+		CreatedAt:  time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		UpdatedAt:  time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+		Input:      map[string]interface{}{"variables": []interface{}{"text"}},
+		Output:     map[string]interface{}{"type": "text"},
+		Configuration: map[string]interface{}{
+			"system_prompt":     "Summarize.",
+			"completion_prompt": "{{text}}",
+		},
+		IsDefaultVersion: true,
+	}
+
+	var diags diag.Diagnostics
+	model := mapCapabilityRepresentationToModel(context.Background(), capability, &diags)
+
+	if diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+	if model.SystemPrompt.ValueString() != "Summarize." || model.CompletionPrompt.ValueString() != "{{text}}" || model.OutputType.ValueString() != "text" {
+		t.Errorf("Unexpected type-specific fields: %+v", model)
+	}
+	if !model.CollectionIDs.IsNull() || !model.ProjectID.IsNull() || !model.Version.IsNull() {
+		t.Errorf("Expected absent fields to be null: %+v", model)
+	}
+	if model.Input.ValueString() != `{"variables":["text"]}` || model.CreatedAt.ValueString() != "2024-01-01T00:00:00Z" {
+		t.Errorf("Unexpected input or created_at: %s, %s", model.Input, model.CreatedAt)
+	}
+	if !model.IsDefaultVersion.Equal(types.BoolValue(true)) {
+		t.Errorf("Expected is_default_version to be true")
+	}
+}
+
+func TestCapabilitiesListFilter(t *testing.T) {
+	if got := capabilitiesListFilter("", "", ""); got != "" {
+		t.Errorf("capabilitiesListFilter() = %q, want empty", got)
+	}
+	if got := capabilitiesListFilter("chat", "", "alice"); got != "type::chat|owner::alice" {
+		t.Errorf("capabilitiesListFilter() = %q, want %q", got, "type::chat|owner::alice")
+	}
+	if got := capabilitiesListFilter("chat", "project-1", "alice"); got != "type::chat|project_id::project-1|owner::alice" {
+		t.Errorf("capabilitiesListFilter() = %q, want %q", got, "type::chat|project_id::project-1|owner::alice")
+	}
+}
@@ -0,0 +1,224 @@
+// Copyright (c) Trifork
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-corax/internal/coraxclient"
+	api "terraform-provider-corax/internal/generated"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CapabilityDataSource{}
+
+func NewCapabilityDataSource() datasource.DataSource {
+	return &CapabilityDataSource{}
+}
+
+// CapabilityDataSource defines the data source implementation.
+type CapabilityDataSource struct {
+	client *coraxclient.Client
+}
+
+// CapabilityDataSourceModel describes the data source data model.
+// The type-specific fields are null for capability types that do not have them.
+type CapabilityDataSourceModel struct {
+	CapabilityModel
+	IsDefaultVersion types.Bool   `tfsdk:"is_default_version"`
+	SystemPrompt     types.String `tfsdk:"system_prompt"`     // Nullable; chat, completion and extraction
+	CompletionPrompt types.String `tfsdk:"completion_prompt"` // Nullable; completion
+	OutputType       types.String `tfsdk:"output_type"`       // Nullable; completion and extraction
+	CollectionIDs    types.List   `tfsdk:"collection_ids"`    // Nullable, list of strings; chat
+	Input            types.String `tfsdk:"input"`             // JSON object
+	Output           types.String `tfsdk:"output"`            // JSON object
+	Configuration    types.String `tfsdk:"configuration"`     // JSON object
+}
+
+// mapCapabilityRepresentationToModel maps an api.CapabilityRepresentation to the Terraform model.
+// DefaultVersion is left null.
+func mapCapabilityRepresentationToModel(ctx context.Context, capability *api.CapabilityRepresentation, diags *diag.Diagnostics) CapabilityDataSourceModel {
+	model := CapabilityDataSourceModel{
+		CapabilityModel: CapabilityModel{
+			ID:             types.StringValue(capability.Id),
+			SemanticID:     types.StringValue(capability.SemanticId),
+			Name:           types.StringValue(capability.Name),
+			Type:           types.StringValue(capability.Type),
+			IsPublic:       types.BoolPointerValue(capability.IsPublic.Get()),
+			ModelID:        types.StringPointerValue(capability.ModelId.Get()),
+			ModelPoolID:    types.StringPointerValue(capability.ModelPoolId.Get()),
+			ProjectID:      types.StringPointerValue(capability.ProjectId.Get()),
+			Owner:          types.StringValue(capability.Owner),
+			Version:        optionalInt32Value(capability.Version),
+			DefaultVersion: types.Int64Null(),
+			CreatedBy:      types.StringValue(capability.CreatedBy),
+			UpdatedBy:      types.StringValue(capability.UpdatedBy),
+			CreatedAt:      types.StringValue(capability.CreatedAt.Format(time.RFC3339)),
+			UpdatedAt:      types.StringValue(capability.UpdatedAt.Format(time.RFC3339)),
+			ArchivedAt:     optionalTimestampValue(capability.ArchivedAt.Get()),
+		},
+		IsDefaultVersion: types.BoolValue(capability.IsDefaultVersion),
+		SystemPrompt:     types.StringNull(),
+		CompletionPrompt: types.StringNull(),
+		OutputType:       types.StringNull(),
+		CollectionIDs:    types.ListNull(types.StringType),
+	}
+
+	if systemPrompt, ok := capability.Configuration["system_prompt"].(string); ok {
+		model.SystemPrompt = types.StringValue(systemPrompt)
+	}
+	if completionPrompt, ok := capability.Configuration["completion_prompt"].(string); ok {
+		model.CompletionPrompt = types.StringValue(completionPrompt)
+	}
+
+	// Extraction capabilities report the output type in the configuration, completion capabilities in the output.
+	if outputType, ok := capability.Configuration["output_type"].(string); ok {
+		model.OutputType = types.StringValue(outputType)
+	} else if outputType, ok := capability.Output["type"].(string); ok {
+		model.OutputType = types.StringValue(outputType)
+	}
+
+	if rawIDs, ok := capability.Configuration["collection_ids"].([]interface{}); ok {
+		collectionIDs := []string{}
+		for _, rawID := range rawIDs {
+			if id, ok := rawID.(string); ok {
+				collectionIDs = append(collectionIDs, id)
+			}
+		}
+		collectionIDsVal, d := types.ListValueFrom(ctx, types.StringType, collectionIDs)
+		diags.Append(d...)
+		model.CollectionIDs = collectionIDsVal
+	}
+
+	model.Input = jsonObjectAPIToString(types.StringNull(), capability.Input, diags)
+	model.Output = jsonObjectAPIToString(types.StringNull(), capability.Output, diags)
+	model.Configuration = jsonObjectAPIToString(types.StringNull(), capability.Configuration, diags)
+	return model
+}
+
+func (d *CapabilityDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_capability"
+}
+
+func (d *CapabilityDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := capabilitySchemaAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "The UUID of the capability to look up. Exactly one of `id` and `semantic_id` must be set.",
+		Validators: []validator.String{
+			stringvalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("semantic_id")),
+		},
+	}
+	attributes["semantic_id"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "The semantic ID of the capability to look up. Exactly one of `id` and `semantic_id` must be set.",
+		Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+	}
+	attributes["is_default_version"] = schema.BoolAttribute{
+		Computed:            true,
+		MarkdownDescription: "Whether the returned version is the default version of the capability.",
+	}
+	attributes["system_prompt"] = schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The system prompt of a `chat`, `completion` or `extraction` capability.",
+	}
+	attributes["completion_prompt"] = schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The completion prompt of a `completion` capability.",
+	}
+	attributes["output_type"] = schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The output type of a `completion` or `extraction` capability, e.g. `text` or `schema`.",
+	}
+	attributes["collection_ids"] = schema.ListAttribute{
+		Computed:            true,
+		ElementType:         types.StringType,
+		MarkdownDescription: "The UUIDs of the collections a `chat` capability retrieves from.",
+	}
+	attributes["input"] = schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The input definition of the capability as a JSON object string. Use `jsondecode()` to read it.",
+	}
+	attributes["output"] = schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The output definition of the capability as a JSON object string. Use `jsondecode()` to read it.",
+	}
+	attributes["configuration"] = schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The type-specific configuration of the capability as a JSON object string. Use `jsondecode()` to read it.",
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up a Corax capability of any type by ID or semantic ID, including its type-specific fields and current default version.",
+		Attributes:          attributes,
+	}
+}
+
+func (d *CapabilityDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*coraxclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *coraxclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *CapabilityDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CapabilityDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The API accepts either the ID or the semantic ID of a capability.
+	lookupPath := path.Root("id")
+	lookup := data.ID.ValueString()
+	if data.ID.IsNull() {
+		lookupPath = path.Root("semantic_id")
+		lookup = data.SemanticID.ValueString()
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Looking up capability %q", lookup))
+
+	capability, err := d.client.GetCapability(ctx, lookup)
+	if err != nil {
+		if errors.Is(err, coraxclient.ErrNotFound) {
+			resp.Diagnostics.AddAttributeError(lookupPath, "Capability Not Found", fmt.Sprintf("No capability %q exists or is visible to the caller.", lookup))
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read capability %s, got error: %s", lookup, err))
+		return
+	}
+
+	versions, err := d.client.ListCapabilityVersions(ctx, capability.Id)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list versions of capability %s, got error: %s", capability.Id, err))
+		return
+	}
+
+	data = mapCapabilityRepresentationToModel(ctx, capability, &resp.Diagnostics)
+	data.DefaultVersion = defaultCapabilityVersion(versions)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,89 @@
+// Copyright (c) Trifork
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-corax/internal/coraxclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ComplianceAssetDataSource{}
+
+func NewComplianceAssetDataSource() datasource.DataSource {
+	return &ComplianceAssetDataSource{}
+}
+
+// ComplianceAssetDataSource defines the data source implementation.
+// Its data model is ComplianceAssetModel, shared with corax_compliance_assets.
+type ComplianceAssetDataSource struct {
+	client *coraxclient.Client
+}
+
+func (d *ComplianceAssetDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_compliance_asset"
+}
+
+func (d *ComplianceAssetDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := complianceAssetSchemaAttributes()
+	attributes["capability_id"] = schema.StringAttribute{
+		Required:            true,
+		MarkdownDescription: "The UUID of the capability.",
+		Validators:          []validator.String{uuidValidator()},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads the compliance status of a Corax capability, with the result of every applicable policy and control.",
+		Attributes:          attributes,
+	}
+}
+
+func (d *ComplianceAssetDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*coraxclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *coraxclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *ComplianceAssetDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ComplianceAssetModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	requireFeature(d.client, coraxclient.FeatureCompliance, "corax_compliance_asset", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	capabilityID := data.CapabilityID.ValueString()
+	tflog.Debug(ctx, fmt.Sprintf("Reading compliance asset %s", capabilityID))
+
+	asset, err := d.client.GetComplianceAsset(ctx, capabilityID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read compliance status of capability %s, got error: %s", capabilityID, err))
+		return
+	}
+
+	data = mapComplianceAssetToModel(ctx, asset, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
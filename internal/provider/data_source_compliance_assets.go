@@ -0,0 +1,340 @@
+// Copyright (c) Trifork
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-corax/internal/coraxclient"
+	api "terraform-provider-corax/internal/generated"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ComplianceAssetsDataSource{}
+
+func NewComplianceAssetsDataSource() datasource.DataSource {
+	return &ComplianceAssetsDataSource{}
+}
+
+// ComplianceAssetsDataSource defines the data source implementation.
+type ComplianceAssetsDataSource struct {
+	client *coraxclient.Client
+}
+
+// ComplianceAssetsDataSourceModel describes the data source data model.
+type ComplianceAssetsDataSourceModel struct {
+	ProjectID types.String `tfsdk:"project_id"` // Optional filter
+	Status    types.String `tfsdk:"status"`     // Optional filter
+	Summary   types.Object `tfsdk:"summary"`    // ComplianceSummaryModel
+	Assets    types.List   `tfsdk:"assets"`     // List of ComplianceAssetModel
+}
+
+// ComplianceAssetModel maps to components.schemas.AssetResponse.
+// It is also the data model of the corax_compliance_asset data source.
+type ComplianceAssetModel struct {
+	CapabilityID     types.String `tfsdk:"capability_id"`
+	CapabilityName   types.String `tfsdk:"capability_name"`
+	ProjectID        types.String `tfsdk:"project_id"`   // Nullable
+	ProjectName      types.String `tfsdk:"project_name"` // Nullable
+	ComplianceStatus types.String `tfsdk:"compliance_status"`
+	PolicyCount      types.Int64  `tfsdk:"policy_count"`
+	PolicyResults    types.List   `tfsdk:"policy_results"` // List of CompliancePolicyResultModel
+}
+
+// CompliancePolicyResultModel maps to components.schemas.PolicyComplianceResult.
+type CompliancePolicyResultModel struct {
+	PolicyID       types.String `tfsdk:"policy_id"`
+	PolicyName     types.String `tfsdk:"policy_name"`
+	Compliant      types.Bool   `tfsdk:"compliant"`
+	ControlResults types.List   `tfsdk:"control_results"` // List of ComplianceControlResultModel
+}
+
+// ComplianceControlResultModel maps to components.schemas.ControlResult.
+type ComplianceControlResultModel struct {
+	ControlName   types.String `tfsdk:"control_name"`
+	Compliant     types.Bool   `tfsdk:"compliant"`
+	RequiredValue types.String `tfsdk:"required_value"`
+	ActualValue   types.String `tfsdk:"actual_value"`
+}
+
+// ComplianceSummaryModel maps to components.schemas.ComplianceSummary.
+type ComplianceSummaryModel struct {
+	TotalAssets       types.Int64 `tfsdk:"total_assets"`
+	CompliantCount    types.Int64 `tfsdk:"compliant_count"`
+	NonCompliantCount types.Int64 `tfsdk:"non_compliant_count"`
+}
+
+func complianceControlResultAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"control_name":   types.StringType,
+		"compliant":      types.BoolType,
+		"required_value": types.StringType,
+		"actual_value":   types.StringType,
+	}
+}
+
+func compliancePolicyResultAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"policy_id":       types.StringType,
+		"policy_name":     types.StringType,
+		"compliant":       types.BoolType,
+		"control_results": types.ListType{ElemType: types.ObjectType{AttrTypes: complianceControlResultAttributeTypes()}},
+	}
+}
+
+func complianceAssetAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"capability_id":     types.StringType,
+		"capability_name":   types.StringType,
+		"project_id":        types.StringType,
+		"project_name":      types.StringType,
+		"compliance_status": types.StringType,
+		"policy_count":      types.Int64Type,
+		"policy_results":    types.ListType{ElemType: types.ObjectType{AttrTypes: compliancePolicyResultAttributeTypes()}},
+	}
+}
+
+func complianceSummaryAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"total_assets":        types.Int64Type,
+		"compliant_count":     types.Int64Type,
+		"non_compliant_count": types.Int64Type,
+	}
+}
+
+// complianceAssetSchemaAttributes returns the computed attributes of a compliance asset, shared by
+// corax_compliance_assets and corax_compliance_asset.
+func complianceAssetSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"capability_id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The UUID of the capability.",
+		},
+		"capability_name": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The name of the capability.",
+		},
+		"project_id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The UUID of the project the capability belongs to, if any.",
+		},
+		"project_name": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The name of the project the capability belongs to, if any.",
+		},
+		"compliance_status": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The compliance status of the capability: `protected` or `not_protected`.",
+		},
+		"policy_count": schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: "The number of policies that apply to the capability.",
+		},
+		"policy_results": schema.ListNestedAttribute{
+			Computed:            true,
+			MarkdownDescription: "The compliance result per applicable policy.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"policy_id": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "The UUID of the policy.",
+					},
+					"policy_name": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "The name of the policy.",
+					},
+					"compliant": schema.BoolAttribute{
+						Computed:            true,
+						MarkdownDescription: "Whether the capability complies with every control of the policy.",
+					},
+					"control_results": schema.ListNestedAttribute{
+						Computed:            true,
+						MarkdownDescription: "The compliance result per control of the policy.",
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"control_name": schema.StringAttribute{
+									Computed:            true,
+									MarkdownDescription: "The name of the control, e.g. `pii_detection`.",
+								},
+								"compliant": schema.BoolAttribute{
+									Computed:            true,
+									MarkdownDescription: "Whether the capability complies with the control.",
+								},
+								"required_value": schema.StringAttribute{
+									Computed:            true,
+									MarkdownDescription: "The setting the policy requires.",
+								},
+								"actual_value": schema.StringAttribute{
+									Computed:            true,
+									MarkdownDescription: "The setting the capability has.",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// mapComplianceAssetToModel maps an api.AssetResponse to the Terraform model.
+func mapComplianceAssetToModel(ctx context.Context, asset *api.AssetResponse, diags *diag.Diagnostics) ComplianceAssetModel {
+	model := ComplianceAssetModel{
+		CapabilityID:     types.StringValue(asset.CapabilityId),
+		CapabilityName:   types.StringValue(asset.CapabilityName),
+		ProjectID:        types.StringPointerValue(asset.ProjectId.Get()),
+		ProjectName:      types.StringPointerValue(asset.ProjectName.Get()),
+		ComplianceStatus: types.StringValue(string(asset.ComplianceStatus)),
+		PolicyCount:      types.Int64Value(int64(asset.PolicyCount)),
+	}
+
+	policyResults := make([]CompliancePolicyResultModel, 0, len(asset.PolicyResults))
+	for _, policyResult := range asset.PolicyResults {
+		controlResults := make([]ComplianceControlResultModel, 0, len(policyResult.ControlResults))
+		for _, controlResult := range policyResult.ControlResults {
+			controlResults = append(controlResults, ComplianceControlResultModel{
+				ControlName:   types.StringValue(controlResult.ControlName),
+				Compliant:     types.BoolValue(controlResult.Compliant),
+				RequiredValue: types.StringValue(controlResult.RequiredValue),
+				ActualValue:   types.StringValue(controlResult.ActualValue),
+			})
+		}
+		controlResultsVal, controlDiags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: complianceControlResultAttributeTypes()}, controlResults)
+		diags.Append(controlDiags...)
+
+		policyResults = append(policyResults, CompliancePolicyResultModel{
+			PolicyID:       types.StringValue(policyResult.PolicyId),
+			PolicyName:     types.StringValue(policyResult.PolicyName),
+			Compliant:      types.BoolValue(policyResult.Compliant),
+			ControlResults: controlResultsVal,
+		})
+	}
+	policyResultsVal, policyDiags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: compliancePolicyResultAttributeTypes()}, policyResults)
+	diags.Append(policyDiags...)
+	model.PolicyResults = policyResultsVal
+
+	return model
+}
+
+// complianceSummaryFromAssets counts the protected and unprotected assets.
+func complianceSummaryFromAssets(assets []api.AssetResponse) ComplianceSummaryModel {
+	compliant := 0
+	for _, asset := range assets {
+		if asset.ComplianceStatus == api.PROTECTED {
+			compliant++
+		}
+	}
+	return ComplianceSummaryModel{
+		TotalAssets:       types.Int64Value(int64(len(assets))),
+		CompliantCount:    types.Int64Value(int64(compliant)),
+		NonCompliantCount: types.Int64Value(int64(len(assets) - compliant)),
+	}
+}
+
+func (d *ComplianceAssetsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_compliance_assets"
+}
+
+func (d *ComplianceAssetsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the compliance status of Corax capabilities, optionally filtered by project and status, with a summary of how many are compliant.",
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only list capabilities of this project (UUID).",
+				Validators:          []validator.String{uuidValidator()},
+			},
+			"status": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only list capabilities with this compliance status: `protected` or `not_protected`.",
+				Validators:          []validator.String{stringvalidator.OneOf(enumStrings(api.AllowedComplianceStatusEnumValues)...)},
+			},
+			"summary": schema.SingleNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The number of listed assets, and how many of them are compliant (`protected`).",
+				Attributes: map[string]schema.Attribute{
+					"total_assets": schema.Int64Attribute{
+						Computed:            true,
+						MarkdownDescription: "The number of listed assets.",
+					},
+					"compliant_count": schema.Int64Attribute{
+						Computed:            true,
+						MarkdownDescription: "The number of listed assets that are `protected`.",
+					},
+					"non_compliant_count": schema.Int64Attribute{
+						Computed:            true,
+						MarkdownDescription: "The number of listed assets that are `not_protected`.",
+					},
+				},
+			},
+			"assets": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The compliance status per capability.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: complianceAssetSchemaAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *ComplianceAssetsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*coraxclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *coraxclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *ComplianceAssetsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ComplianceAssetsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	requireFeature(d.client, coraxclient.FeatureCompliance, "corax_compliance_assets", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Listing compliance assets")
+	assets, err := d.client.ListComplianceAssets(ctx, data.ProjectID.ValueString(), data.Status.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list compliance assets, got error: %s", err))
+		return
+	}
+
+	models := make([]ComplianceAssetModel, 0, len(assets))
+	for i := range assets {
+		models = append(models, mapComplianceAssetToModel(ctx, &assets[i], &resp.Diagnostics))
+	}
+	assetsVal, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: complianceAssetAttributeTypes()}, models)
+	resp.Diagnostics.Append(diags...)
+	summaryVal, diags := types.ObjectValueFrom(ctx, complianceSummaryAttributeTypes(), complianceSummaryFromAssets(assets))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Assets = assetsVal
+	data.Summary = summaryVal
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
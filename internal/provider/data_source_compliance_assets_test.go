@@ -0,0 +1,142 @@
+// Copyright (c) Trifork
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	api "terraform-provider-corax/internal/generated"
+)
+
+func TestAccComplianceAssetDataSources_basic(t *testing.T) {
+	if os.Getenv("CORAX_API_ENDPOINT") == "" || os.Getenv("CORAX_API_KEY") == "" {
+		t.Skip("Skipping acceptance test: CORAX_API_ENDPOINT or CORAX_API_KEY not set")
+	}
+
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	name := fmt.Sprintf("tf-acc-test-assets-%s", rName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComplianceAssetDataSourcesConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.corax_compliance_assets.test", "assets.#", "1"),
+					resource.TestCheckResourceAttrPair("data.corax_compliance_assets.test", "assets.0.capability_id", "corax_chat_capability.test", "id"),
+					resource.TestCheckResourceAttr("data.corax_compliance_assets.test", "summary.total_assets", "1"),
+					resource.TestCheckResourceAttr("data.corax_compliance_asset.test", "capability_name", name),
+					resource.TestCheckResourceAttr("data.corax_compliance_asset.test", "policy_count", "1"),
+					resource.TestCheckResourceAttrPair("data.corax_compliance_asset.test", "policy_results.0.policy_id", "corax_compliance_policy.test", "id"),
+					resource.TestCheckResourceAttrSet("data.corax_compliance_badge.test", "status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccComplianceAssetDataSourcesConfig(name string) string {
+	return fmt.Sprintf(`
+provider "corax" {}
+
+resource "corax_project" "test" {
+  name = %[1]q
+}
+
+resource "corax_chat_capability" "test" {
+  name          = %[1]q
+  project_id    = corax_project.test.id
+  system_prompt = "You are a helpful assistant."
+}
+
+resource "corax_compliance_policy" "test" {
+  name       = %[1]q
+  scope_type = "project"
+  scope_id   = corax_project.test.id
+
+  prompt_shield {}
+}
+
+data "corax_compliance_assets" "test" {
+  project_id = corax_project.test.id
+
+  depends_on = [corax_chat_capability.test, corax_compliance_policy.test]
+}
+
+data "corax_compliance_asset" "test" {
+  capability_id = corax_chat_capability.test.id
+
+  depends_on = [corax_compliance_policy.test]
+}
+
+data "corax_compliance_badge" "test" {
+  capability_id = corax_chat_capability.test.id
+
+  depends_on = [corax_compliance_policy.test]
+}
+`, name)
+}
+
+func TestMapComplianceAssetToModel(t *testing.T) {
+	ctx := context.Background()
+
+	var asset api.AssetResponse
+	if err := json.Unmarshal([]byte(`{
+		"capability_id": "cap-1",
+		"capability_name": "Chat",
+		"project_id": null,
+		"compliance_status": "not_protected",
+		"policy_count": 1,
+		"policy_results": [{
+			"policy_id": "policy-1",
+			"policy_name": "Baseline",
+			"compliant": false,
+			"control_results": [
+				{"control_name": "pii_detection", "compliant": false, "required_value": "redact", "actual_value": "allow"}
+			]
+		}]
+	}`), &asset); err != nil {
+		t.Fatalf("Failed to unmarshal asset: %v", err)
+	}
+
+	var diags diag.Diagnostics
+	model := mapComplianceAssetToModel(ctx, &asset, &diags)
+	if diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+
+	if !model.ProjectID.IsNull() || !model.ProjectName.IsNull() {
+		t.Errorf("Expected null project, got %s, %s", model.ProjectID, model.ProjectName)
+	}
+	var policyResults []CompliancePolicyResultModel
+	diags.Append(model.PolicyResults.ElementsAs(ctx, &policyResults, false)...)
+	if len(policyResults) != 1 || policyResults[0].Compliant.ValueBool() {
+		t.Fatalf("Unexpected policy results: %+v", policyResults)
+	}
+	var controlResults []ComplianceControlResultModel
+	diags.Append(policyResults[0].ControlResults.ElementsAs(ctx, &controlResults, false)...)
+	if len(controlResults) != 1 || controlResults[0].ActualValue.ValueString() != "allow" {
+		t.Errorf("Unexpected control results: %+v", controlResults)
+	}
+}
+
+func TestComplianceSummaryFromAssets(t *testing.T) {
+	summary := complianceSummaryFromAssets([]api.AssetResponse{
+		{ComplianceStatus: api.PROTECTED},
+		{ComplianceStatus: api.NOT_PROTECTED},
+		{ComplianceStatus: api.PROTECTED},
+	})
+
+	if summary.TotalAssets.ValueInt64() != 3 || summary.CompliantCount.ValueInt64() != 2 || summary.NonCompliantCount.ValueInt64() != 1 {
+		t.Errorf("Unexpected summary: %+v", summary)
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright (c) Trifork
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-corax/internal/coraxclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ComplianceBadgeDataSource{}
+
+func NewComplianceBadgeDataSource() datasource.DataSource {
+	return &ComplianceBadgeDataSource{}
+}
+
+// ComplianceBadgeDataSource defines the data source implementation.
+type ComplianceBadgeDataSource struct {
+	client *coraxclient.Client
+}
+
+// ComplianceBadgeDataSourceModel describes the data source data model.
+// Based on components.schemas.BadgeResponse.
+type ComplianceBadgeDataSourceModel struct {
+	CapabilityID types.String `tfsdk:"capability_id"`
+	Status       types.String `tfsdk:"status"`
+	IsCompliant  types.Bool   `tfsdk:"is_compliant"` // Nullable
+}
+
+func (d *ComplianceBadgeDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_compliance_badge"
+}
+
+func (d *ComplianceBadgeDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads the compliance badge of a Corax capability.",
+		Attributes: map[string]schema.Attribute{
+			"capability_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The UUID of the capability.",
+				Validators:          []validator.String{uuidValidator()},
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The compliance status shown on the badge: `protected` or `not_protected`.",
+			},
+			"is_compliant": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the capability complies with all applicable policies. Null when no policy applies.",
+			},
+		},
+	}
+}
+
+func (d *ComplianceBadgeDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*coraxclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *coraxclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *ComplianceBadgeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ComplianceBadgeDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	requireFeature(d.client, coraxclient.FeatureCompliance, "corax_compliance_badge", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	capabilityID := data.CapabilityID.ValueString()
+	tflog.Debug(ctx, fmt.Sprintf("Reading compliance badge of capability %s", capabilityID))
+
+	badge, err := d.client.GetComplianceBadge(ctx, capabilityID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read compliance badge of capability %s, got error: %s", capabilityID, err))
+		return
+	}
+
+	data.Status = types.StringValue(string(badge.Status))
+	data.IsCompliant = types.BoolPointerValue(badge.IsCompliant.Get())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
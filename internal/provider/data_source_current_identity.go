@@ -0,0 +1,172 @@
+// Copyright (c) Trifork
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-corax/internal/coraxclient"
+	api "terraform-provider-corax/internal/generated"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CurrentIdentityDataSource{}
+
+func NewCurrentIdentityDataSource() datasource.DataSource {
+	return &CurrentIdentityDataSource{}
+}
+
+// CurrentIdentityDataSource defines the data source implementation.
+type CurrentIdentityDataSource struct {
+	client *coraxclient.Client
+}
+
+// CurrentIdentityDataSourceModel describes the data source data model.
+// Based on components.schemas.AuthMeResponse.
+type CurrentIdentityDataSourceModel struct {
+	RequiredPermissions types.Set    `tfsdk:"required_permissions"` // Optional, set of strings
+	UserID              types.String `tfsdk:"user_id"`
+	Username            types.String `tfsdk:"username"`
+	RoleName            types.String `tfsdk:"role_name"` // Nullable
+	Permissions         types.Set    `tfsdk:"permissions"`
+	IsSuperuser         types.Bool   `tfsdk:"is_superuser"`
+	AuthSource          types.String `tfsdk:"auth_source"`
+}
+
+// missingPermissions returns the required permissions the identity does not have, sorted.
+// Superusers have every permission.
+func missingPermissions(me *api.AuthMeResponse, required []string) []string {
+	if me.GetIsSuperuser() {
+		return nil
+	}
+
+	granted := make(map[string]bool, len(me.Permissions))
+	for _, p := range me.Permissions {
+		granted[p] = true
+	}
+
+	var missing []string
+	for _, p := range required {
+		if !granted[p] {
+			missing = append(missing, p)
+		}
+	}
+	sort.Strings(missing)
+	return missing
+}
+
+func (d *CurrentIdentityDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_current_identity"
+}
+
+func (d *CurrentIdentityDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads the identity the provider is authenticated as, with its role and permissions. " +
+			"Set `required_permissions` to fail early when the configured credentials lack rights a configuration needs.",
+		Attributes: map[string]schema.Attribute{
+			"required_permissions": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				MarkdownDescription: "Permissions the identity must have, e.g. `[\"users:manage\", \"roles:manage\"]`. " +
+					"Reading the data source fails with the missing permissions when any is lacking. Superusers have every permission.",
+			},
+			"user_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the user the credentials belong to.",
+			},
+			"username": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The username of the user the credentials belong to.",
+			},
+			"role_name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The name of the user's role, if any.",
+			},
+			"permissions": schema.SetAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The permissions of the identity.",
+			},
+			"is_superuser": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the identity is a superuser.",
+			},
+			"auth_source": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "How the provider authenticated: `jwt`, `api_key` or `legacy_api_key`.",
+			},
+		},
+	}
+}
+
+func (d *CurrentIdentityDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*coraxclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *coraxclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *CurrentIdentityDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CurrentIdentityDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading current identity")
+	me, err := d.client.GetCurrentIdentity(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read current identity, got error: %s", err))
+		return
+	}
+
+	if !data.RequiredPermissions.IsNull() {
+		var required []string
+		resp.Diagnostics.Append(data.RequiredPermissions.ElementsAs(ctx, &required, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if missing := missingPermissions(me, required); len(missing) > 0 {
+			resp.Diagnostics.AddAttributeError(path.Root("required_permissions"), "Insufficient Permissions",
+				fmt.Sprintf("The provider is authenticated as %q (%s), which lacks the permissions: %s. "+
+					"Use credentials with these permissions, e.g. an API key of an administrator.",
+					me.Username, me.AuthSource, strings.Join(missing, ", ")))
+			return
+		}
+	}
+
+	grantedPermissions := me.Permissions
+	if grantedPermissions == nil {
+		grantedPermissions = []string{}
+	}
+	permissions, diags := types.SetValueFrom(ctx, types.StringType, grantedPermissions)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.UserID = types.StringValue(me.UserId)
+	data.Username = types.StringValue(me.Username)
+	data.RoleName = types.StringPointerValue(me.RoleName.Get())
+	data.Permissions = permissions
+	data.IsSuperuser = types.BoolValue(me.GetIsSuperuser())
+	data.AuthSource = types.StringValue(me.AuthSource)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
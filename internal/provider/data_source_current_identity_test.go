@@ -0,0 +1,55 @@
+// Copyright (c) Trifork
+
+package provider
+
+import (
+	"os"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	api "terraform-provider-corax/internal/generated"
+)
+
+func TestAccCurrentIdentityDataSource_basic(t *testing.T) {
+	if os.Getenv("CORAX_API_ENDPOINT") == "" || os.Getenv("CORAX_API_KEY") == "" {
+		t.Skip("Skipping acceptance test: CORAX_API_ENDPOINT or CORAX_API_KEY not set")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "corax" {}
+
+data "corax_current_identity" "test" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.corax_current_identity.test", "user_id"),
+					resource.TestCheckResourceAttrSet("data.corax_current_identity.test", "username"),
+					resource.TestMatchResourceAttr("data.corax_current_identity.test", "auth_source", regexp.MustCompile(`^(jwt|api_key|legacy_api_key)$`)),
+				),
+			},
+		},
+	})
+}
+
+func TestMissingPermissions(t *testing.T) {
+	me := &api.AuthMeResponse{UserId: "user-1", Permissions: []string{"projects:read", "users:manage"}}
+
+	if missing := missingPermissions(me, []string{"users:manage"}); len(missing) != 0 {
+		t.Errorf("Expected no missing permissions, got %v", missing)
+	}
+	if missing := missingPermissions(me, []string{"roles:manage", "projects:read", "capabilities:write"}); !reflect.DeepEqual(missing, []string{"capabilities:write", "roles:manage"}) {
+		t.Errorf("Unexpected missing permissions %v", missing)
+	}
+
+	me.SetIsSuperuser(true)
+	if missing := missingPermissions(me, []string{"roles:manage"}); len(missing) != 0 {
+		t.Errorf("Expected a superuser to have every permission, got %v", missing)
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright (c) Trifork
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-corax/internal/coraxclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &FeaturesDataSource{}
+
+func NewFeaturesDataSource() datasource.DataSource {
+	return &FeaturesDataSource{}
+}
+
+// FeaturesDataSource defines the data source implementation.
+type FeaturesDataSource struct {
+	client *coraxclient.Client
+}
+
+// FeaturesDataSourceModel describes the data source data model.
+type FeaturesDataSourceModel struct {
+	Features types.Map  `tfsdk:"features"` // Map of bools
+	Enabled  types.List `tfsdk:"enabled"`  // List of strings
+}
+
+// enabledFeatures returns the names of the enabled features, sorted.
+func enabledFeatures(features map[string]bool) []string {
+	enabled := []string{}
+	for name, on := range features {
+		if on {
+			enabled = append(enabled, name)
+		}
+	}
+	sort.Strings(enabled)
+	return enabled
+}
+
+func (d *FeaturesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_features"
+}
+
+func (d *FeaturesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads the feature flags of the Corax server, e.g. to create compliance policies or MCP servers only where the feature is enabled.",
+		Attributes: map[string]schema.Attribute{
+			"features": schema.MapAttribute{
+				Computed:            true,
+				ElementType:         types.BoolType,
+				MarkdownDescription: "Whether each feature reported by the server is enabled, keyed by feature name.",
+			},
+			"enabled": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The names of the enabled features, sorted.",
+			},
+		},
+	}
+}
+
+func (d *FeaturesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*coraxclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *coraxclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *FeaturesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data FeaturesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading feature flags")
+	features, err := d.client.GetFeatures(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read feature flags, got error: %s", err))
+		return
+	}
+	if features == nil {
+		features = map[string]bool{}
+	}
+
+	featuresVal, diags := types.MapValueFrom(ctx, types.BoolType, features)
+	resp.Diagnostics.Append(diags...)
+	enabledVal, diags := types.ListValueFrom(ctx, types.StringType, enabledFeatures(features))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Features = featuresVal
+	data.Enabled = enabledVal
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,46 @@
+// Copyright (c) Trifork
+
+package provider
+
+import (
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFeaturesDataSource_basic(t *testing.T) {
+	if os.Getenv("CORAX_API_ENDPOINT") == "" || os.Getenv("CORAX_API_KEY") == "" {
+		t.Skip("Skipping acceptance test: CORAX_API_ENDPOINT or CORAX_API_KEY not set")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "corax" {}
+
+data "corax_features" "test" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.corax_features.test", "features.%"),
+					resource.TestCheckResourceAttrSet("data.corax_features.test", "enabled.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestEnabledFeatures(t *testing.T) {
+	features := map[string]bool{"mcp_servers": true, "compliance": true, "evaluations": false}
+
+	if result := enabledFeatures(features); !reflect.DeepEqual(result, []string{"compliance", "mcp_servers"}) {
+		t.Errorf("Unexpected enabled features %v", result)
+	}
+	if result := enabledFeatures(nil); result == nil || len(result) != 0 {
+		t.Errorf("Expected an empty, non-nil list, got %#v", result)
+	}
+}
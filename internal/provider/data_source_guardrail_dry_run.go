@@ -0,0 +1,360 @@
+// Copyright (c) Trifork
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-corax/internal/coraxclient"
+	api "terraform-provider-corax/internal/generated"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GuardrailDryRunDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &GuardrailDryRunDataSource{}
+
+func NewGuardrailDryRunDataSource() datasource.DataSource {
+	return &GuardrailDryRunDataSource{}
+}
+
+// GuardrailDryRunDataSource defines the data source implementation.
+type GuardrailDryRunDataSource struct {
+	client *coraxclient.Client
+}
+
+// GuardrailDryRunDataSourceModel describes the data source data model.
+// Based on components.schemas.DryRunRequest and components.schemas.DryRunResponse.
+type GuardrailDryRunDataSourceModel struct {
+	Text             types.String `tfsdk:"text"`
+	PolicyID         types.String `tfsdk:"policy_id"`         // Nullable
+	ContentFilter    types.Object `tfsdk:"content_filter"`    // PolicyContentFilterModel
+	PromptShield     types.Object `tfsdk:"prompt_shield"`     // PolicyEnabledControlModel
+	PiiDetection     types.Object `tfsdk:"pii_detection"`     // PolicyPiiDetectionModel
+	TopicRestriction types.Object `tfsdk:"topic_restriction"` // PolicyTopicRestrictionModel
+	Groundedness     types.Object `tfsdk:"groundedness"`      // PolicyEnabledControlModel
+	Passed           types.Bool   `tfsdk:"passed"`
+	Summary          types.String `tfsdk:"summary"`
+	Results          types.List   `tfsdk:"results"` // List of GuardrailScanResultModel
+}
+
+// GuardrailScanResultModel maps to components.schemas.ScanResult.
+type GuardrailScanResultModel struct {
+	Passed         types.Bool    `tfsdk:"passed"`
+	Category       types.String  `tfsdk:"category"`
+	Reason         types.String  `tfsdk:"reason"`          // Nullable
+	DetectedItems  types.List    `tfsdk:"detected_items"`  // List of strings
+	SanitizedText  types.String  `tfsdk:"sanitized_text"`  // Nullable
+	Score          types.Float64 `tfsdk:"score"`           // Nullable
+	ScannerWarning types.Bool    `tfsdk:"scanner_warning"` // Nullable
+}
+
+func guardrailScanResultAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"passed":          types.BoolType,
+		"category":        types.StringType,
+		"reason":          types.StringType,
+		"detected_items":  types.ListType{ElemType: types.StringType},
+		"sanitized_text":  types.StringType,
+		"score":           types.Float64Type,
+		"scanner_warning": types.BoolType,
+	}
+}
+
+// guardrailDryRunHasControls reports whether any inline control block is configured.
+func guardrailDryRunHasControls(data GuardrailDryRunDataSourceModel) bool {
+	for _, block := range []types.Object{data.ContentFilter, data.PromptShield, data.PiiDetection, data.TopicRestriction, data.Groundedness} {
+		if !block.IsNull() {
+			return true
+		}
+	}
+	return false
+}
+
+// mapGuardrailScanResultsToModel maps the scan results of an api.DryRunResponse to the Terraform model.
+// The dry run passes when every scan passed.
+func mapGuardrailScanResultsToModel(ctx context.Context, response *api.DryRunResponse, data *GuardrailDryRunDataSourceModel, diags *diag.Diagnostics) {
+	passed := true
+	results := make([]GuardrailScanResultModel, 0, len(response.Results))
+	for _, scan := range response.Results {
+		passed = passed && scan.Passed
+
+		result := GuardrailScanResultModel{
+			Passed:         types.BoolValue(scan.Passed),
+			Category:       types.StringValue(string(scan.Category)),
+			Reason:         types.StringNull(),
+			SanitizedText:  types.StringNull(),
+			Score:          types.Float64Null(),
+			ScannerWarning: types.BoolNull(),
+		}
+		if reason, ok := scan.GetReasonOk(); ok && reason != nil {
+			result.Reason = types.StringValue(*reason)
+		}
+		if sanitized, ok := scan.GetSanitizedTextOk(); ok && sanitized != nil {
+			result.SanitizedText = types.StringValue(*sanitized)
+		}
+		if score, ok := scan.GetScoreOk(); ok && score != nil {
+			result.Score = types.Float64Value(float32ToFloat64(*score))
+		}
+		if scan.ScannerWarning != nil {
+			result.ScannerWarning = types.BoolValue(*scan.ScannerWarning)
+		}
+		detectedItems := scan.DetectedItems
+		if detectedItems == nil {
+			detectedItems = []string{}
+		}
+		listVal, listDiags := types.ListValueFrom(ctx, types.StringType, detectedItems)
+		diags.Append(listDiags...)
+		result.DetectedItems = listVal
+
+		results = append(results, result)
+	}
+
+	resultsVal, resultsDiags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: guardrailScanResultAttributeTypes()}, results)
+	diags.Append(resultsDiags...)
+	data.Results = resultsVal
+	data.Passed = types.BoolValue(passed)
+	data.Summary = types.StringValue(response.Summary)
+}
+
+func (d *GuardrailDryRunDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_guardrail_dry_run"
+}
+
+func guardrailEnabledAttribute(control string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Optional:            true,
+		MarkdownDescription: fmt.Sprintf("Whether %s is enforced. Defaults to true.", control),
+	}
+}
+
+func (d *GuardrailDryRunDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Scans sample text with Corax guardrails without executing a capability or persisting anything. " +
+			"The controls are either taken from an existing compliance policy (`policy_id`) or configured inline with the same blocks as `corax_compliance_policy`. " +
+			"Combine it with `check` blocks to regression test guardrail configurations.",
+		Attributes: map[string]schema.Attribute{
+			"text": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The sample text to scan.",
+			},
+			"policy_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The UUID of the compliance policy whose controls are used. Conflicts with the inline control blocks.",
+				Validators:          []validator.String{uuidValidator()},
+			},
+			"passed": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether every scan passed.",
+			},
+			"summary": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "A human-readable summary of the scan results.",
+			},
+			"results": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The result of each guardrail scan.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"passed": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the text passed the scan.",
+						},
+						"category": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The guardrail that performed the scan, e.g. `pii_detection`.",
+						},
+						"reason": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Why the scan did not pass, if reported.",
+						},
+						"detected_items": schema.ListAttribute{
+							ElementType:         types.StringType,
+							Computed:            true,
+							MarkdownDescription: "The items the scan detected, e.g. PII entity types or topics.",
+						},
+						"sanitized_text": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The text after redaction, if the guardrail redacts.",
+						},
+						"score": schema.Float64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The score of the scan, if reported.",
+						},
+						"scanner_warning": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the scanner reported a warning instead of a result, e.g. because it was unavailable.",
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"content_filter": schema.SingleNestedBlock{
+				MarkdownDescription: "Filters harmful content above a severity per category.",
+				Attributes: map[string]schema.Attribute{
+					"enabled": guardrailEnabledAttribute("the content filter"),
+					"severity_thresholds": schema.MapAttribute{
+						ElementType:         types.StringType,
+						Optional:            true, // Required when the block is present, see ValidateConfig
+						MarkdownDescription: "Severity threshold (`low`, `medium` or `high`) per content category (`violence`, `sexual`, `hate` or `self_harm`).",
+						Validators: []validator.Map{
+							mapvalidator.KeysAre(stringvalidator.OneOf(enumStrings(api.AllowedContentFilterCategoryEnumValues)...)),
+							mapvalidator.ValueStringsAre(stringvalidator.OneOf(enumStrings(api.AllowedSeverityLevelEnumValues)...)),
+						},
+					},
+				},
+			},
+			"prompt_shield": schema.SingleNestedBlock{
+				MarkdownDescription: "Detects prompt injection and jailbreak attempts.",
+				Attributes: map[string]schema.Attribute{
+					"enabled": guardrailEnabledAttribute("the prompt shield"),
+				},
+			},
+			"pii_detection": schema.SingleNestedBlock{
+				MarkdownDescription: "Detects personally identifiable information.",
+				Attributes: map[string]schema.Attribute{
+					"enabled": guardrailEnabledAttribute("PII detection"),
+					"input_mode": schema.StringAttribute{
+						Optional:            true, // Required when the block is present, see ValidateConfig
+						MarkdownDescription: "How PII in inputs is handled: `allow`, `block` or `redact`.",
+						Validators:          []validator.String{stringvalidator.OneOf(enumStrings(api.AllowedPiiEnforcementModeEnumValues)...)},
+					},
+					"output_mode": schema.StringAttribute{
+						Optional:            true, // Required when the block is present, see ValidateConfig
+						MarkdownDescription: "How PII in outputs is handled: `allow`, `block` or `redact`.",
+						Validators:          []validator.String{stringvalidator.OneOf(enumStrings(api.AllowedPiiEnforcementModeEnumValues)...)},
+					},
+				},
+			},
+			"topic_restriction": schema.SingleNestedBlock{
+				MarkdownDescription: "Restricts the topics that may be discussed.",
+				Attributes: map[string]schema.Attribute{
+					"enabled": guardrailEnabledAttribute("the topic restriction"),
+					"mode": schema.StringAttribute{
+						Optional:            true, // Required when the block is present, see ValidateConfig
+						MarkdownDescription: "`allowlist` to only allow the topics, `blocklist` to block them.",
+						Validators:          []validator.String{stringvalidator.OneOf(enumStrings(api.AllowedTopicRestrictionModeEnumValues)...)},
+					},
+					"topics": schema.ListAttribute{
+						ElementType:         types.StringType,
+						Optional:            true, // Required when the block is present, see ValidateConfig
+						MarkdownDescription: "The topics to allow or block.",
+						Validators:          []validator.List{listvalidator.SizeAtLeast(1)},
+					},
+					"threshold": schema.Float64Attribute{
+						Optional:            true,
+						MarkdownDescription: "Confidence threshold (0.0 to 1.0) for topic classification. Defaults to 0.75.",
+						Validators:          []validator.Float64{float64validator.Between(0, 1)},
+					},
+				},
+			},
+			"groundedness": schema.SingleNestedBlock{
+				MarkdownDescription: "Checks that the text is grounded in the provided sources.",
+				Attributes: map[string]schema.Attribute{
+					"enabled": guardrailEnabledAttribute("the groundedness check"),
+				},
+			},
+		},
+	}
+}
+
+func (d *GuardrailDryRunDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*coraxclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *coraxclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *GuardrailDryRunDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data GuardrailDryRunDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hasControls := guardrailDryRunHasControls(data)
+	if !data.PolicyID.IsUnknown() {
+		if !data.PolicyID.IsNull() && hasControls {
+			resp.Diagnostics.AddAttributeError(path.Root("policy_id"), "Conflicting Guardrail Controls",
+				"policy_id can't be combined with inline control blocks, use one or the other.")
+		}
+		if data.PolicyID.IsNull() && !hasControls {
+			resp.Diagnostics.AddError("Missing Guardrail Controls",
+				"Either policy_id or at least one inline control block (content_filter, prompt_shield, pii_detection, topic_restriction or groundedness) must be set.")
+		}
+	}
+
+	requireBlockAttribute(data.ContentFilter, "severity_thresholds", &resp.Diagnostics, "content_filter")
+	requireBlockAttribute(data.PiiDetection, "input_mode", &resp.Diagnostics, "pii_detection")
+	requireBlockAttribute(data.PiiDetection, "output_mode", &resp.Diagnostics, "pii_detection")
+	requireBlockAttribute(data.TopicRestriction, "mode", &resp.Diagnostics, "topic_restriction")
+	requireBlockAttribute(data.TopicRestriction, "topics", &resp.Diagnostics, "topic_restriction")
+}
+
+func (d *GuardrailDryRunDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GuardrailDryRunDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	requireFeature(d.client, coraxclient.FeatureCompliance, "corax_guardrail_dry_run", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := api.NewDryRunRequest(data.Text.ValueString())
+	if !data.PolicyID.IsNull() {
+		request.SetPolicyId(data.PolicyID.ValueString())
+	} else {
+		// The inline blocks match the guardrail controls of a compliance policy.
+		controls := compliancePolicyControlsToAPI(ctx, CompliancePolicyResourceModel{
+			ContentFilter:    data.ContentFilter,
+			PromptShield:     data.PromptShield,
+			PiiDetection:     data.PiiDetection,
+			TopicRestriction: data.TopicRestriction,
+			Groundedness:     data.Groundedness,
+			DataRetention:    types.ObjectNull(policyDataRetentionAttributeTypes()),
+			ContentTracing:   types.ObjectNull(policyContentTracingAttributeTypes()),
+		}, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		request.SetControls(controls)
+	}
+
+	tflog.Debug(ctx, "Running guardrail dry run")
+	response, err := d.client.DryRunGuardrails(ctx, *request)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to run guardrail dry run, got error: %s", err))
+		return
+	}
+
+	mapGuardrailScanResultsToModel(ctx, response, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,331 @@
+// Copyright (c) Trifork
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-corax/internal/coraxclient"
+	api "terraform-provider-corax/internal/generated"
+)
+
+// guardrailEventsDefaultMaxEvents is the number of events read when max_events is not set.
+const guardrailEventsDefaultMaxEvents = 1000
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GuardrailEventsDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &GuardrailEventsDataSource{}
+
+func NewGuardrailEventsDataSource() datasource.DataSource {
+	return &GuardrailEventsDataSource{}
+}
+
+// GuardrailEventsDataSource defines the data source implementation.
+type GuardrailEventsDataSource struct {
+	client *coraxclient.Client
+}
+
+// GuardrailEventsDataSourceModel describes the data source data model.
+// Based on components.schemas.GuardrailEventListResponse.
+type GuardrailEventsDataSourceModel struct {
+	CapabilityID types.String `tfsdk:"capability_id"` // Optional filter
+	PolicyID     types.String `tfsdk:"policy_id"`     // Optional filter
+	ControlType  types.String `tfsdk:"control_type"`  // Optional filter
+	Direction    types.String `tfsdk:"direction"`     // Optional filter
+	FromDate     types.String `tfsdk:"from_date"`     // Optional filter
+	ToDate       types.String `tfsdk:"to_date"`       // Optional filter
+	MaxEvents    types.Int64  `tfsdk:"max_events"`
+	Total        types.Int64  `tfsdk:"total"`
+	Truncated    types.Bool   `tfsdk:"truncated"`
+	Events       types.List   `tfsdk:"events"` // List of GuardrailEventModel
+}
+
+// GuardrailEventModel maps to components.schemas.GuardrailEventResponse.
+type GuardrailEventModel struct {
+	ID             types.String `tfsdk:"id"`
+	Timestamp      types.String `tfsdk:"timestamp"`
+	CapabilityID   types.String `tfsdk:"capability_id"`
+	CapabilityName types.String `tfsdk:"capability_name"`
+	ExecutionID    types.String `tfsdk:"execution_id"`
+	PolicyID       types.String `tfsdk:"policy_id"`
+	PolicyName     types.String `tfsdk:"policy_name"`
+	ControlType    types.String `tfsdk:"control_type"`
+	Direction      types.String `tfsdk:"direction"`
+	Reason         types.String `tfsdk:"reason"`
+	DetectedItems  types.List   `tfsdk:"detected_items"` // List of strings
+	UserContext    types.String `tfsdk:"user_context"`   // Nullable
+	ScannerVersion types.String `tfsdk:"scanner_version"`
+}
+
+func guardrailEventAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":              types.StringType,
+		"timestamp":       types.StringType,
+		"capability_id":   types.StringType,
+		"capability_name": types.StringType,
+		"execution_id":    types.StringType,
+		"policy_id":       types.StringType,
+		"policy_name":     types.StringType,
+		"control_type":    types.StringType,
+		"direction":       types.StringType,
+		"reason":          types.StringType,
+		"detected_items":  types.ListType{ElemType: types.StringType},
+		"user_context":    types.StringType,
+		"scanner_version": types.StringType,
+	}
+}
+
+// parseOptionalTimestamp parses an optional RFC3339 attribute. Null values return nil.
+func parseOptionalTimestamp(value types.String) (*time.Time, error) {
+	if value.IsNull() || value.IsUnknown() {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value.ValueString())
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// mapGuardrailEventToModel maps an api.GuardrailEventResponse to the Terraform model.
+func mapGuardrailEventToModel(ctx context.Context, event *api.GuardrailEventResponse, diags *diag.Diagnostics) GuardrailEventModel {
+	detectedItems := event.DetectedItems
+	if detectedItems == nil {
+		detectedItems = []string{}
+	}
+	detectedItemsVal, listDiags := types.ListValueFrom(ctx, types.StringType, detectedItems)
+	diags.Append(listDiags...)
+
+	return GuardrailEventModel{
+		ID:             types.StringValue(event.Id),
+		Timestamp:      types.StringValue(event.Timestamp.Format(time.RFC3339)),
+		CapabilityID:   types.StringValue(event.CapabilityId),
+		CapabilityName: types.StringValue(event.CapabilityName),
+		ExecutionID:    types.StringValue(event.ExecutionId),
+		PolicyID:       types.StringValue(event.PolicyId),
+		PolicyName:     types.StringValue(event.PolicyName),
+		ControlType:    types.StringValue(event.ControlType),
+		Direction:      types.StringValue(event.Direction),
+		Reason:         types.StringValue(event.Reason),
+		DetectedItems:  detectedItemsVal,
+		UserContext:    types.StringPointerValue(event.UserContext.Get()),
+		ScannerVersion: types.StringValue(event.ScannerVersion),
+	}
+}
+
+func (d *GuardrailEventsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_guardrail_events"
+}
+
+func (d *GuardrailEventsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists Corax guardrail events, i.e. guardrail violations recorded while executing capabilities. " +
+			"All pages are read until `max_events` events are returned; `truncated` tells whether more events matched.",
+		Attributes: map[string]schema.Attribute{
+			"capability_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only list events of this capability (UUID).",
+				Validators:          []validator.String{uuidValidator()},
+			},
+			"policy_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only list events caused by this compliance policy (UUID).",
+				Validators:          []validator.String{uuidValidator()},
+			},
+			"control_type": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only list events of this guardrail: `content_filter`, `prompt_shield`, `pii_detection`, `topic_restriction` or `groundedness`.",
+				Validators:          []validator.String{stringvalidator.OneOf(enumStrings(api.AllowedScanCategoryEnumValues)...)},
+			},
+			"direction": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only list events on capability `input` or `output`.",
+				Validators:          []validator.String{stringvalidator.OneOf(enumStrings(api.AllowedScanDirectionEnumValues)...)},
+			},
+			"from_date": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only list events at or after this time (RFC3339 format).",
+				Validators:          []validator.String{rfc3339Validator{}},
+			},
+			"to_date": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only list events at or before this time (RFC3339 format).",
+				Validators:          []validator.String{rfc3339Validator{}},
+			},
+			"max_events": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: fmt.Sprintf("The maximum number of events to read. Defaults to %d.", guardrailEventsDefaultMaxEvents),
+				Validators:          []validator.Int64{int64validator.AtLeast(1)},
+			},
+			"total": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The number of events matching the filters, which may be more than were read.",
+			},
+			"truncated": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether more events matched than `max_events`.",
+			},
+			"events": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The matching events, as ordered by the API.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The unique identifier of the event.",
+						},
+						"timestamp": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The time of the event (RFC3339 format).",
+						},
+						"capability_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The UUID of the capability that was executed.",
+						},
+						"capability_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the capability that was executed.",
+						},
+						"execution_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the capability execution.",
+						},
+						"policy_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The UUID of the policy that required the guardrail.",
+						},
+						"policy_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the policy that required the guardrail.",
+						},
+						"control_type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The guardrail that raised the event.",
+						},
+						"direction": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the event was raised on `input` or `output`.",
+						},
+						"reason": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Why the guardrail raised the event.",
+						},
+						"detected_items": schema.ListAttribute{
+							ElementType:         types.StringType,
+							Computed:            true,
+							MarkdownDescription: "The items the guardrail detected, e.g. PII entity types or topics.",
+						},
+						"user_context": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The user context of the execution, if any.",
+						},
+						"scanner_version": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The version of the scanner that raised the event.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *GuardrailEventsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*coraxclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *coraxclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *GuardrailEventsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data GuardrailEventsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Invalid timestamps are reported by the attribute validators.
+	from, fromErr := parseOptionalTimestamp(data.FromDate)
+	to, toErr := parseOptionalTimestamp(data.ToDate)
+	if fromErr == nil && toErr == nil && from != nil && to != nil && from.After(*to) {
+		resp.Diagnostics.AddAttributeError(path.Root("to_date"), "Invalid Time Window",
+			fmt.Sprintf("to_date (%s) must not be before from_date (%s).", data.ToDate.ValueString(), data.FromDate.ValueString()))
+	}
+}
+
+func (d *GuardrailEventsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GuardrailEventsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	requireFeature(d.client, coraxclient.FeatureCompliance, "corax_guardrail_events", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := coraxclient.GuardrailEventFilter{
+		CapabilityID: data.CapabilityID.ValueString(),
+		PolicyID:     data.PolicyID.ValueString(),
+		ControlType:  data.ControlType.ValueString(),
+		Direction:    data.Direction.ValueString(),
+	}
+	var err error
+	if filter.FromDate, err = parseOptionalTimestamp(data.FromDate); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("from_date"), "Invalid Timestamp", err.Error())
+	}
+	if filter.ToDate, err = parseOptionalTimestamp(data.ToDate); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("to_date"), "Invalid Timestamp", err.Error())
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	maxEvents := guardrailEventsDefaultMaxEvents
+	if !data.MaxEvents.IsNull() {
+		maxEvents = int(data.MaxEvents.ValueInt64())
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Listing up to %d guardrail events", maxEvents))
+	events, total, err := d.client.ListGuardrailEvents(ctx, filter, maxEvents)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list guardrail events, got error: %s", err))
+		return
+	}
+
+	models := make([]GuardrailEventModel, 0, len(events))
+	for i := range events {
+		models = append(models, mapGuardrailEventToModel(ctx, &events[i], &resp.Diagnostics))
+	}
+	eventsVal, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: guardrailEventAttributeTypes()}, models)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Events = eventsVal
+	data.Total = types.Int64Value(int64(total))
+	data.Truncated = types.BoolValue(total > len(events))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
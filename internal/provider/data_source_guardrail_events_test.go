@@ -0,0 +1,107 @@
+// Copyright (c) Trifork
+
+package provider
+
+import (
+	"context"
+	"os"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	api "terraform-provider-corax/internal/generated"
+)
+
+func TestAccGuardrailEventsDataSource_basic(t *testing.T) {
+	if os.Getenv("CORAX_API_ENDPOINT") == "" || os.Getenv("CORAX_API_KEY") == "" {
+		t.Skip("Skipping acceptance test: CORAX_API_ENDPOINT or CORAX_API_KEY not set")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "corax" {}
+
+data "corax_guardrail_events" "test" {
+  control_type = "pii_detection"
+  from_date    = "2024-01-01T00:00:00Z"
+  max_events   = 5
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.corax_guardrail_events.test", "total"),
+					resource.TestCheckResourceAttrSet("data.corax_guardrail_events.test", "truncated"),
+				),
+			},
+			{
+				Config: `
+provider "corax" {}
+
+data "corax_guardrail_events" "test" {
+  from_date = "2024-02-01T00:00:00Z"
+  to_date   = "2024-01-01T00:00:00Z"
+}
+`,
+				ExpectError: regexp.MustCompile("Invalid Time Window"),
+			},
+		},
+	})
+}
+
+func TestMapGuardrailEventToModel(t *testing.T) {
+	event := api.GuardrailEventResponse{
+		Id:             "event-1",
+		Timestamp:      time.Date(2024, 3, 1, 12, 30, 0, 0, time.UTC),
+		CapabilityId:   "cap-1",
+		ControlType:    "pii_detection",
+		Direction:      "input",
+		Reason:         "PII detected",
+		ScannerVersion: "1.2.0",
+	}
+
+	var diags diag.Diagnostics
+	model := mapGuardrailEventToModel(context.Background(), &event, &diags)
+	if diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+
+	if model.Timestamp.ValueString() != "2024-03-01T12:30:00Z" {
+		t.Errorf("Unexpected timestamp %s", model.Timestamp)
+	}
+	if model.DetectedItems.IsNull() || len(model.DetectedItems.Elements()) != 0 {
+		t.Errorf("Expected empty detected items, got %s", model.DetectedItems)
+	}
+	if !model.UserContext.IsNull() {
+		t.Errorf("Expected null user context, got %s", model.UserContext)
+	}
+}
+
+func TestRFC3339Validator(t *testing.T) {
+	tests := []struct {
+		value     types.String
+		expectErr bool
+	}{
+		{value: types.StringValue("2024-01-31T12:00:00Z")},
+		{value: types.StringValue("2024-01-31T12:00:00+01:00")},
+		{value: types.StringNull()},
+		{value: types.StringValue("2024-01-31"), expectErr: true},
+		{value: types.StringValue("yesterday"), expectErr: true},
+	}
+
+	for _, tt := range tests {
+		resp := &validator.StringResponse{}
+		rfc3339Validator{}.ValidateString(context.Background(), validator.StringRequest{Path: path.Root("from_date"), ConfigValue: tt.value}, resp)
+		if resp.Diagnostics.HasError() != tt.expectErr {
+			t.Errorf("%s: expected error %v, got %v", tt.value, tt.expectErr, resp.Diagnostics)
+		}
+	}
+}
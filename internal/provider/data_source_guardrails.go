@@ -0,0 +1,322 @@
+// Copyright (c) Trifork
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-corax/internal/coraxclient"
+	api "terraform-provider-corax/internal/generated"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GuardrailsDataSource{}
+
+func NewGuardrailsDataSource() datasource.DataSource {
+	return &GuardrailsDataSource{}
+}
+
+// GuardrailsDataSource defines the data source implementation.
+type GuardrailsDataSource struct {
+	client *coraxclient.Client
+}
+
+// GuardrailsDataSourceModel describes the data source data model.
+type GuardrailsDataSourceModel struct {
+	ProjectID types.String `tfsdk:"project_id"` // Optional filter
+	SortBy    types.String `tfsdk:"sort_by"`
+	SortOrder types.String `tfsdk:"sort_order"`
+	Rows      types.List   `tfsdk:"rows"` // List of GuardrailRowModel
+}
+
+// GuardrailRowModel maps to components.schemas.GuardrailRow. All guardrail settings are nullable.
+type GuardrailRowModel struct {
+	CapabilityID              types.String  `tfsdk:"capability_id"`
+	CapabilityName            types.String  `tfsdk:"capability_name"`
+	ProjectID                 types.String  `tfsdk:"project_id"`
+	ProjectName               types.String  `tfsdk:"project_name"`
+	DataRetentionType         types.String  `tfsdk:"data_retention_type"`
+	DataRetentionHours        types.Int64   `tfsdk:"data_retention_hours"`
+	ContentTracing            types.Bool    `tfsdk:"content_tracing"`
+	ContentFilterEnabled      types.Bool    `tfsdk:"content_filter_enabled"`
+	ContentFilterThresholds   types.Map     `tfsdk:"content_filter_thresholds"` // Map of category to severity level
+	PromptShieldEnabled       types.Bool    `tfsdk:"prompt_shield_enabled"`
+	PiiDetectionEnabled       types.Bool    `tfsdk:"pii_detection_enabled"`
+	PiiDetectionMode          types.String  `tfsdk:"pii_detection_mode"`
+	PiiDetectionInputMode     types.String  `tfsdk:"pii_detection_input_mode"`
+	PiiDetectionOutputMode    types.String  `tfsdk:"pii_detection_output_mode"`
+	TopicRestrictionEnabled   types.Bool    `tfsdk:"topic_restriction_enabled"`
+	TopicRestrictionMode      types.String  `tfsdk:"topic_restriction_mode"`
+	TopicRestrictionTopics    types.List    `tfsdk:"topic_restriction_topics"` // List of strings
+	TopicRestrictionThreshold types.Float64 `tfsdk:"topic_restriction_threshold"`
+	GroundednessEnabled       types.Bool    `tfsdk:"groundedness_enabled"`
+	ControlCompliance         types.Map     `tfsdk:"control_compliance"` // Map of control to compliance status
+}
+
+func guardrailRowAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"capability_id":               types.StringType,
+		"capability_name":             types.StringType,
+		"project_id":                  types.StringType,
+		"project_name":                types.StringType,
+		"data_retention_type":         types.StringType,
+		"data_retention_hours":        types.Int64Type,
+		"content_tracing":             types.BoolType,
+		"content_filter_enabled":      types.BoolType,
+		"content_filter_thresholds":   types.MapType{ElemType: types.StringType},
+		"prompt_shield_enabled":       types.BoolType,
+		"pii_detection_enabled":       types.BoolType,
+		"pii_detection_mode":          types.StringType,
+		"pii_detection_input_mode":    types.StringType,
+		"pii_detection_output_mode":   types.StringType,
+		"topic_restriction_enabled":   types.BoolType,
+		"topic_restriction_mode":      types.StringType,
+		"topic_restriction_topics":    types.ListType{ElemType: types.StringType},
+		"topic_restriction_threshold": types.Float64Type,
+		"groundedness_enabled":        types.BoolType,
+		"control_compliance":          types.MapType{ElemType: types.StringType},
+	}
+}
+
+// piiEnforcementModeValue converts a nullable PII enforcement mode to a Terraform string.
+func piiEnforcementModeValue(mode api.NullablePiiEnforcementMode) types.String {
+	if mode.Get() == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(string(*mode.Get()))
+}
+
+// mapGuardrailRowToModel maps an api.GuardrailRow to the Terraform model.
+func mapGuardrailRowToModel(ctx context.Context, row *api.GuardrailRow, diags *diag.Diagnostics) GuardrailRowModel {
+	model := GuardrailRowModel{
+		CapabilityID:              types.StringValue(row.CapabilityId),
+		CapabilityName:            types.StringValue(row.CapabilityName),
+		ProjectID:                 types.StringPointerValue(row.ProjectId.Get()),
+		ProjectName:               types.StringPointerValue(row.ProjectName.Get()),
+		DataRetentionType:         types.StringPointerValue(row.DataRetentionType.Get()),
+		DataRetentionHours:        types.Int64Null(),
+		ContentTracing:            types.BoolPointerValue(row.ContentTracing.Get()),
+		ContentFilterEnabled:      types.BoolPointerValue(row.ContentFilterEnabled.Get()),
+		ContentFilterThresholds:   types.MapNull(types.StringType),
+		PromptShieldEnabled:       types.BoolPointerValue(row.PromptShieldEnabled.Get()),
+		PiiDetectionEnabled:       types.BoolPointerValue(row.PiiDetectionEnabled.Get()),
+		PiiDetectionMode:          piiEnforcementModeValue(row.PiiDetectionMode),
+		PiiDetectionInputMode:     piiEnforcementModeValue(row.PiiDetectionInputMode),
+		PiiDetectionOutputMode:    piiEnforcementModeValue(row.PiiDetectionOutputMode),
+		TopicRestrictionEnabled:   types.BoolPointerValue(row.TopicRestrictionEnabled.Get()),
+		TopicRestrictionMode:      types.StringNull(),
+		TopicRestrictionTopics:    types.ListNull(types.StringType),
+		TopicRestrictionThreshold: types.Float64Null(),
+		GroundednessEnabled:       types.BoolPointerValue(row.GroundednessEnabled.Get()),
+		ControlCompliance:         types.MapNull(types.StringType),
+	}
+	if hours := row.DataRetentionHours.Get(); hours != nil {
+		model.DataRetentionHours = types.Int64Value(int64(*hours))
+	}
+	if mode := row.TopicRestrictionMode.Get(); mode != nil {
+		model.TopicRestrictionMode = types.StringValue(string(*mode))
+	}
+	if threshold := row.TopicRestrictionThreshold.Get(); threshold != nil {
+		model.TopicRestrictionThreshold = types.Float64Value(float32ToFloat64(*threshold))
+	}
+	if row.ContentFilterThresholds != nil {
+		thresholds := make(map[string]string, len(row.ContentFilterThresholds))
+		for category, level := range row.ContentFilterThresholds {
+			thresholds[category] = string(level)
+		}
+		mapVal, mapDiags := types.MapValueFrom(ctx, types.StringType, thresholds)
+		diags.Append(mapDiags...)
+		model.ContentFilterThresholds = mapVal
+	}
+	if row.TopicRestrictionTopics != nil {
+		listVal, listDiags := types.ListValueFrom(ctx, types.StringType, row.TopicRestrictionTopics)
+		diags.Append(listDiags...)
+		model.TopicRestrictionTopics = listVal
+	}
+	if row.ControlCompliance != nil {
+		compliance := make(map[string]string, len(row.ControlCompliance))
+		for control, status := range row.ControlCompliance {
+			compliance[control] = string(status)
+		}
+		mapVal, mapDiags := types.MapValueFrom(ctx, types.StringType, compliance)
+		diags.Append(mapDiags...)
+		model.ControlCompliance = mapVal
+	}
+	return model
+}
+
+func (d *GuardrailsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_guardrails"
+}
+
+func (d *GuardrailsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the guardrail settings of every Corax capability as a matrix, one row per capability, with the compliance status per control.",
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only list capabilities of this project (UUID).",
+				Validators:          []validator.String{uuidValidator()},
+			},
+			"sort_by": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The row attribute to sort by, e.g. `capability_name` or `project_name`. Defaults to `capability_name`.",
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"sort_order": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The sort order: `asc` or `desc`. Defaults to `asc`.",
+				Validators:          []validator.String{stringvalidator.OneOf("asc", "desc")},
+			},
+			"rows": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The guardrail settings per capability. Settings that are not configured on a capability are null.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"capability_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The UUID of the capability.",
+						},
+						"capability_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the capability.",
+						},
+						"project_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The UUID of the project the capability belongs to, if any.",
+						},
+						"project_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the project the capability belongs to, if any.",
+						},
+						"data_retention_type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The data retention type: `infinite` or `timed`.",
+						},
+						"data_retention_hours": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The number of hours data is retained for `timed` retention.",
+						},
+						"content_tracing": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether content tracing is enabled.",
+						},
+						"content_filter_enabled": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the content filter is enabled.",
+						},
+						"content_filter_thresholds": schema.MapAttribute{
+							ElementType:         types.StringType,
+							Computed:            true,
+							MarkdownDescription: "The content filter severity threshold per content category.",
+						},
+						"prompt_shield_enabled": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the prompt shield is enabled.",
+						},
+						"pii_detection_enabled": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether PII detection is enabled.",
+						},
+						"pii_detection_mode": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The overall PII enforcement mode: `allow`, `block` or `redact`.",
+						},
+						"pii_detection_input_mode": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The PII enforcement mode for inputs.",
+						},
+						"pii_detection_output_mode": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The PII enforcement mode for outputs.",
+						},
+						"topic_restriction_enabled": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the topic restriction is enabled.",
+						},
+						"topic_restriction_mode": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The topic restriction mode: `allowlist` or `blocklist`.",
+						},
+						"topic_restriction_topics": schema.ListAttribute{
+							ElementType:         types.StringType,
+							Computed:            true,
+							MarkdownDescription: "The allowed or blocked topics.",
+						},
+						"topic_restriction_threshold": schema.Float64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The confidence threshold for topic classification.",
+						},
+						"groundedness_enabled": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the groundedness check is enabled.",
+						},
+						"control_compliance": schema.MapAttribute{
+							ElementType:         types.StringType,
+							Computed:            true,
+							MarkdownDescription: "The compliance status (`compliant` or `non_compliant`) per control required by a policy.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *GuardrailsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*coraxclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *coraxclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *GuardrailsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GuardrailsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	requireFeature(d.client, coraxclient.FeatureCompliance, "corax_guardrails", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Listing guardrails")
+	rows, err := d.client.ListGuardrails(ctx, data.ProjectID.ValueString(), data.SortBy.ValueString(), data.SortOrder.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list guardrails, got error: %s", err))
+		return
+	}
+
+	models := make([]GuardrailRowModel, 0, len(rows))
+	for i := range rows {
+		models = append(models, mapGuardrailRowToModel(ctx, &rows[i], &resp.Diagnostics))
+	}
+	rowsVal, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: guardrailRowAttributeTypes()}, models)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Rows = rowsVal
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
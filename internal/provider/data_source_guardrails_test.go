@@ -0,0 +1,113 @@
+// Copyright (c) Trifork
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	api "terraform-provider-corax/internal/generated"
+)
+
+func TestAccGuardrailsDataSource_basic(t *testing.T) {
+	if os.Getenv("CORAX_API_ENDPOINT") == "" || os.Getenv("CORAX_API_KEY") == "" {
+		t.Skip("Skipping acceptance test: CORAX_API_ENDPOINT or CORAX_API_KEY not set")
+	}
+
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	name := fmt.Sprintf("tf-acc-test-guardrails-%s", rName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGuardrailsDataSourceConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.corax_guardrails.test", "rows.#", "2"),
+					resource.TestCheckResourceAttr("data.corax_guardrails.test", "rows.0.capability_name", name+"-b"),
+					resource.TestCheckResourceAttr("data.corax_guardrails.test", "rows.1.capability_name", name+"-a"),
+				),
+			},
+		},
+	})
+}
+
+func testAccGuardrailsDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+provider "corax" {}
+
+resource "corax_project" "test" {
+  name = %[1]q
+}
+
+resource "corax_chat_capability" "a" {
+  name          = "%[1]s-a"
+  project_id    = corax_project.test.id
+  system_prompt = "You are a helpful assistant."
+}
+
+resource "corax_chat_capability" "b" {
+  name          = "%[1]s-b"
+  project_id    = corax_project.test.id
+  system_prompt = "You are a helpful assistant."
+}
+
+data "corax_guardrails" "test" {
+  project_id = corax_project.test.id
+  sort_by    = "capability_name"
+  sort_order = "desc"
+
+  depends_on = [corax_chat_capability.a, corax_chat_capability.b]
+}
+`, name)
+}
+
+func TestMapGuardrailRowToModel(t *testing.T) {
+	ctx := context.Background()
+
+	var row api.GuardrailRow
+	if err := json.Unmarshal([]byte(`{
+		"capability_id": "cap-1",
+		"capability_name": "Chat",
+		"data_retention_type": "timed",
+		"data_retention_hours": 24,
+		"content_filter_thresholds": {"violence": "low"},
+		"pii_detection_input_mode": "redact",
+		"topic_restriction_mode": "blocklist",
+		"topic_restriction_topics": ["politics"],
+		"topic_restriction_threshold": 0.8,
+		"control_compliance": {"pii_detection": "non_compliant"}
+	}`), &row); err != nil {
+		t.Fatalf("Failed to unmarshal row: %v", err)
+	}
+
+	var diags diag.Diagnostics
+	model := mapGuardrailRowToModel(ctx, &row, &diags)
+	if diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+
+	if model.DataRetentionHours.ValueInt64() != 24 || model.TopicRestrictionThreshold.ValueFloat64() != 0.8 {
+		t.Errorf("Unexpected numbers: %s, %s", model.DataRetentionHours, model.TopicRestrictionThreshold)
+	}
+	if model.PiiDetectionInputMode.ValueString() != "redact" || !model.PiiDetectionOutputMode.IsNull() {
+		t.Errorf("Unexpected PII modes: %s, %s", model.PiiDetectionInputMode, model.PiiDetectionOutputMode)
+	}
+	if !model.PromptShieldEnabled.IsNull() || !model.ProjectID.IsNull() {
+		t.Errorf("Expected unset settings to be null, got %s, %s", model.PromptShieldEnabled, model.ProjectID)
+	}
+	if len(model.ContentFilterThresholds.Elements()) != 1 || len(model.TopicRestrictionTopics.Elements()) != 1 {
+		t.Errorf("Unexpected collections: %s, %s", model.ContentFilterThresholds, model.TopicRestrictionTopics)
+	}
+	if got := model.ControlCompliance.Elements()["pii_detection"]; got == nil || got.String() != `"non_compliant"` {
+		t.Errorf("Unexpected control compliance: %s", model.ControlCompliance)
+	}
+}
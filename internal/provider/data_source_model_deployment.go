@@ -0,0 +1,143 @@
+// Copyright (c) Trifork
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-corax/internal/coraxclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ModelDeploymentDataSource{}
+
+func NewModelDeploymentDataSource() datasource.DataSource {
+	return &ModelDeploymentDataSource{}
+}
+
+// ModelDeploymentDataSource defines the data source implementation.
+// Its data model is ModelDeploymentModel, shared with the corax_model_deployments data source.
+type ModelDeploymentDataSource struct {
+	client *coraxclient.Client
+}
+
+// findModelDeploymentsByName returns the model deployments whose name equals name exactly.
+func findModelDeploymentsByName(deployments []coraxclient.ModelDeployment, name string) []coraxclient.ModelDeployment {
+	var matches []coraxclient.ModelDeployment
+	for _, deployment := range deployments {
+		if deployment.Name == name {
+			matches = append(matches, deployment)
+		}
+	}
+	return matches
+}
+
+func (d *ModelDeploymentDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_model_deployment"
+}
+
+func (d *ModelDeploymentDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := modelDeploymentSchemaAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "The UUID of the model deployment to look up. Exactly one of `id` and `name` must be set.",
+		Validators: []validator.String{
+			uuidValidator(),
+			stringvalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+		},
+	}
+	attributes["name"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "The exact name of the model deployment to look up. The lookup fails when several model deployments have this name. Exactly one of `id` and `name` must be set.",
+		Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up a Corax model deployment by ID or exact name.",
+		Attributes:          attributes,
+	}
+}
+
+func (d *ModelDeploymentDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*coraxclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *coraxclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *ModelDeploymentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ModelDeploymentModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var deployment *coraxclient.ModelDeployment
+	if !data.ID.IsNull() {
+		deploymentID := data.ID.ValueString()
+		tflog.Debug(ctx, fmt.Sprintf("Looking up model deployment %s", deploymentID))
+
+		var err error
+		deployment, err = d.client.GetModelDeployment(ctx, deploymentID)
+		if err != nil {
+			if errors.Is(err, coraxclient.ErrNotFound) {
+				resp.Diagnostics.AddAttributeError(path.Root("id"), "Model Deployment Not Found", fmt.Sprintf("No model deployment with ID %q exists.", deploymentID))
+				return
+			}
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read model deployment %s, got error: %s", deploymentID, err))
+			return
+		}
+	} else {
+		name := data.Name.ValueString()
+		tflog.Debug(ctx, fmt.Sprintf("Looking up model deployment %q", name))
+
+		deployments, err := d.client.ListModelDeployments(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list model deployments, got error: %s", err))
+			return
+		}
+
+		matches := findModelDeploymentsByName(deployments, name)
+		switch len(matches) {
+		case 0:
+			resp.Diagnostics.AddAttributeError(path.Root("name"), "Model Deployment Not Found", fmt.Sprintf("No model deployment named %q exists.", name))
+			return
+		case 1:
+			deployment = &matches[0]
+		default:
+			ids := make([]string, 0, len(matches))
+			for _, match := range matches {
+				ids = append(ids, match.ID)
+			}
+			resp.Diagnostics.AddAttributeError(path.Root("name"), "Ambiguous Model Deployment Name",
+				fmt.Sprintf("%d model deployments are named %q: %s. Look the model deployment up by id instead.", len(matches), name, strings.Join(ids, ", ")))
+			return
+		}
+	}
+
+	data = mapModelDeploymentToModel(ctx, deployment, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,266 @@
+// Copyright (c) Trifork
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-corax/internal/coraxclient"
+	api "terraform-provider-corax/internal/generated"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ModelDeploymentsDataSource{}
+
+func NewModelDeploymentsDataSource() datasource.DataSource {
+	return &ModelDeploymentsDataSource{}
+}
+
+// ModelDeploymentsDataSource defines the data source implementation.
+type ModelDeploymentsDataSource struct {
+	client *coraxclient.Client
+}
+
+// ModelDeploymentsDataSourceModel describes the data source data model.
+type ModelDeploymentsDataSourceModel struct {
+	SupportedTasks   types.Set    `tfsdk:"supported_tasks"`   // Optional filter, set of strings
+	ProviderID       types.String `tfsdk:"provider_id"`       // Optional filter
+	IsActive         types.Bool   `tfsdk:"is_active"`         // Optional filter
+	ModelDeployments types.List   `tfsdk:"model_deployments"` // List of ModelDeploymentModel
+}
+
+// ModelDeploymentModel maps to components.schemas.ModelDeployment.
+// It is also embedded in the data model of the corax_model_deployment data source.
+type ModelDeploymentModel struct {
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Description    types.String `tfsdk:"description"` // Nullable
+	SupportedTasks types.List   `tfsdk:"supported_tasks"`
+	Configuration  types.Map    `tfsdk:"configuration"` // Map of strings
+	IsActive       types.Bool   `tfsdk:"is_active"`
+	ProviderID     types.String `tfsdk:"provider_id"`
+	CreatedBy      types.String `tfsdk:"created_by"`
+	CreatedAt      types.String `tfsdk:"created_at"` // RFC3339
+	UpdatedBy      types.String `tfsdk:"updated_by"` // Nullable
+	UpdatedAt      types.String `tfsdk:"updated_at"` // Nullable, RFC3339
+}
+
+func modelDeploymentAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":              types.StringType,
+		"name":            types.StringType,
+		"description":     types.StringType,
+		"supported_tasks": types.ListType{ElemType: types.StringType},
+		"configuration":   types.MapType{ElemType: types.StringType},
+		"is_active":       types.BoolType,
+		"provider_id":     types.StringType,
+		"created_by":      types.StringType,
+		"created_at":      types.StringType,
+		"updated_by":      types.StringType,
+		"updated_at":      types.StringType,
+	}
+}
+
+// modelDeploymentSchemaAttributes returns the computed attributes of a model deployment, shared by corax_model_deployments and corax_model_deployment.
+func modelDeploymentSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The UUID of the model deployment.",
+		},
+		"name": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The name of the model deployment.",
+		},
+		"description": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The description of the model deployment, if any.",
+		},
+		"supported_tasks": schema.ListAttribute{
+			Computed:            true,
+			ElementType:         types.StringType,
+			MarkdownDescription: "The capability types the model deployment supports, e.g. `chat`.",
+		},
+		"configuration": schema.MapAttribute{
+			Computed:            true,
+			ElementType:         types.StringType,
+			MarkdownDescription: "The configuration of the model deployment, e.g. the model name.",
+		},
+		"is_active": schema.BoolAttribute{
+			Computed:            true,
+			MarkdownDescription: "Whether the model deployment is active.",
+		},
+		"provider_id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The UUID of the model provider the deployment belongs to.",
+		},
+		"created_by": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The ID of the user who created the model deployment.",
+		},
+		"created_at": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The date and time the model deployment was created (RFC3339 format).",
+		},
+		"updated_by": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The ID of the user who last updated the model deployment, if it was updated.",
+		},
+		"updated_at": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The date and time the model deployment was last updated (RFC3339 format), if it was updated.",
+		},
+	}
+}
+
+// modelDeploymentIsActive reports whether a deployment is active. The API defaults is_active to true.
+func modelDeploymentIsActive(deployment *coraxclient.ModelDeployment) bool {
+	return deployment.IsActive == nil || *deployment.IsActive
+}
+
+// mapModelDeploymentToModel maps a coraxclient.ModelDeployment to the Terraform model.
+func mapModelDeploymentToModel(ctx context.Context, deployment *coraxclient.ModelDeployment, diags *diag.Diagnostics) ModelDeploymentModel {
+	supportedTasks, d := types.ListValueFrom(ctx, types.StringType, deployment.SupportedTasks)
+	diags.Append(d...)
+	configuration, d := types.MapValueFrom(ctx, types.StringType, deployment.Configuration)
+	diags.Append(d...)
+
+	return ModelDeploymentModel{
+		ID:             types.StringValue(deployment.ID),
+		Name:           types.StringValue(deployment.Name),
+		Description:    types.StringPointerValue(deployment.Description),
+		SupportedTasks: supportedTasks,
+		Configuration:  configuration,
+		IsActive:       types.BoolValue(modelDeploymentIsActive(deployment)),
+		ProviderID:     types.StringValue(deployment.ProviderID),
+		CreatedBy:      types.StringValue(deployment.CreatedBy),
+		CreatedAt:      types.StringValue(deployment.CreatedAt),
+		UpdatedBy:      types.StringPointerValue(deployment.UpdatedBy),
+		UpdatedAt:      types.StringPointerValue(deployment.UpdatedAt),
+	}
+}
+
+// filterModelDeployments returns the deployments that support every task in supportedTasks and match providerID
+// and isActive when they are set.
+func filterModelDeployments(deployments []coraxclient.ModelDeployment, supportedTasks []string, providerID string, isActive *bool) []coraxclient.ModelDeployment {
+	matches := []coraxclient.ModelDeployment{}
+	for _, deployment := range deployments {
+		if providerID != "" && deployment.ProviderID != providerID {
+			continue
+		}
+		if isActive != nil && modelDeploymentIsActive(&deployment) != *isActive {
+			continue
+		}
+		supportsAll := true
+		for _, task := range supportedTasks {
+			if !slices.Contains(deployment.SupportedTasks, task) {
+				supportsAll = false
+				break
+			}
+		}
+		if supportsAll {
+			matches = append(matches, deployment)
+		}
+	}
+	return matches
+}
+
+func (d *ModelDeploymentsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_model_deployments"
+}
+
+func (d *ModelDeploymentsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the Corax model deployments, optionally filtered by supported tasks, model provider and whether they are active.",
+		Attributes: map[string]schema.Attribute{
+			"supported_tasks": schema.SetAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Only list model deployments that support all of these capability types, e.g. `[\"chat\"]`.",
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(enumStrings(api.AllowedCapabilityTypeEnumValues)...)),
+				},
+			},
+			"provider_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only list model deployments of the model provider with this UUID.",
+				Validators:          []validator.String{uuidValidator()},
+			},
+			"is_active": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only list active (`true`) or inactive (`false`) model deployments.",
+			},
+			"model_deployments": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The model deployments matching the filters.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: modelDeploymentSchemaAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *ModelDeploymentsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*coraxclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *coraxclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *ModelDeploymentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ModelDeploymentsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var supportedTasks []string
+	if !data.SupportedTasks.IsNull() {
+		resp.Diagnostics.Append(data.SupportedTasks.ElementsAs(ctx, &supportedTasks, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	tflog.Debug(ctx, "Listing model deployments")
+	deployments, err := d.client.ListModelDeployments(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list model deployments, got error: %s", err))
+		return
+	}
+	deployments = filterModelDeployments(deployments, supportedTasks, data.ProviderID.ValueString(), data.IsActive.ValueBoolPointer())
+
+	models := make([]ModelDeploymentModel, 0, len(deployments))
+	for i := range deployments {
+		models = append(models, mapModelDeploymentToModel(ctx, &deployments[i], &resp.Diagnostics))
+	}
+	deploymentsVal, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: modelDeploymentAttributeTypes()}, models)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ModelDeployments = deploymentsVal
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
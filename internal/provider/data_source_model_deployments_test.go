@@ -0,0 +1,110 @@
+// Copyright (c) Trifork
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-corax/internal/coraxclient"
+)
+
+func TestAccModelDeploymentsDataSource_basic(t *testing.T) {
+	if os.Getenv("CORAX_API_ENDPOINT") == "" || os.Getenv("CORAX_API_KEY") == "" {
+		t.Skip("Skipping acceptance test: CORAX_API_ENDPOINT or CORAX_API_KEY not set")
+	}
+	providerID := os.Getenv(testAccModelDeploymentProviderIDEnvVar)
+	if providerID == "" {
+		t.Skipf("Skipping acceptance test: %s must be set", testAccModelDeploymentProviderIDEnvVar)
+	}
+
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	name := fmt.Sprintf("tf-acc-test-model-deployments-%s", rName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccModelDeploymentsDataSourceConfig(name, providerID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.corax_model_deployment.by_name", "id", "corax_model_deployment.test", "id"),
+					resource.TestCheckResourceAttr("data.corax_model_deployment.by_name", "configuration.model_name", "gpt-4o"),
+					resource.TestCheckResourceAttrPair("data.corax_model_deployment.by_id", "name", "corax_model_deployment.test", "name"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.corax_model_deployments.test", "model_deployments.*", map[string]string{"name": name}),
+				),
+			},
+		},
+	})
+}
+
+func testAccModelDeploymentsDataSourceConfig(name string, providerID string) string {
+	return fmt.Sprintf(`
+provider "corax" {}
+
+resource "corax_model_deployment" "test" {
+  name            = %[1]q
+  provider_id     = %[2]q
+  supported_tasks = ["chat", "completion"]
+  configuration = {
+    model_name = "gpt-4o"
+  }
+}
+
+data "corax_model_deployments" "test" {
+  supported_tasks = ["chat"]
+  provider_id     = %[2]q
+  is_active       = true
+  depends_on      = [corax_model_deployment.test]
+}
+
+data "corax_model_deployment" "by_name" {
+  name = corax_model_deployment.test.name
+}
+
+data "corax_model_deployment" "by_id" {
+  id = corax_model_deployment.test.id
+}
+`, name, providerID)
+}
+
+func TestFilterModelDeployments(t *testing.T) {
+	inactive := false
+	deployments := []coraxclient.ModelDeployment{
+		{ID: "deployment-1", SupportedTasks: []string{"chat", "completion"}, ProviderID: "provider-1"},
+		{ID: "deployment-2", SupportedTasks: []string{"embedding"}, ProviderID: "provider-1", IsActive: &inactive},
+		{ID: "deployment-3", SupportedTasks: []string{"chat"}, ProviderID: "provider-2"},
+	}
+	active := true
+
+	tests := []struct {
+		name           string
+		supportedTasks []string
+		providerID     string
+		isActive       *bool
+		want           []string
+	}{
+		{name: "no filters", want: []string{"deployment-1", "deployment-2", "deployment-3"}},
+		{name: "one task", supportedTasks: []string{"chat"}, want: []string{"deployment-1", "deployment-3"}},
+		{name: "all tasks", supportedTasks: []string{"chat", "completion"}, want: []string{"deployment-1"}},
+		{name: "provider", providerID: "provider-1", want: []string{"deployment-1", "deployment-2"}},
+		{name: "active defaults to true", providerID: "provider-1", isActive: &active, want: []string{"deployment-1"}},
+		{name: "inactive", isActive: &inactive, want: []string{"deployment-2"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := []string{}
+			for _, deployment := range filterModelDeployments(deployments, tt.supportedTasks, tt.providerID, tt.isActive) {
+				got = append(got, deployment.ID)
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("filterModelDeployments() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
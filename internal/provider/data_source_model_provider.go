@@ -0,0 +1,144 @@
+// Copyright (c) Trifork
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-corax/internal/coraxclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ModelProviderDataSource{}
+
+func NewModelProviderDataSource() datasource.DataSource {
+	return &ModelProviderDataSource{}
+}
+
+// ModelProviderDataSource defines the data source implementation.
+// Its data model is ModelProviderModel, shared with the corax_model_providers data source.
+type ModelProviderDataSource struct {
+	client *coraxclient.Client
+}
+
+// findModelProvidersByName returns the model providers whose name equals name exactly.
+func findModelProvidersByName(providers []coraxclient.ModelProvider, name string) []coraxclient.ModelProvider {
+	var matches []coraxclient.ModelProvider
+	for _, provider := range providers {
+		if provider.Name == name {
+			matches = append(matches, provider)
+		}
+	}
+	return matches
+}
+
+func (d *ModelProviderDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_model_provider"
+}
+
+func (d *ModelProviderDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := modelProviderSchemaAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "The UUID of the model provider to look up. Exactly one of `id` and `name` must be set.",
+		Validators: []validator.String{
+			uuidValidator(),
+			stringvalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+		},
+	}
+	attributes["name"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "The exact name of the model provider to look up. The lookup fails when several model providers have this name. Exactly one of `id` and `name` must be set.",
+		Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up a Corax model provider by ID or exact name. Secret configuration values are redacted.",
+		Attributes:          attributes,
+	}
+}
+
+func (d *ModelProviderDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*coraxclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *coraxclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *ModelProviderDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ModelProviderModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var provider *coraxclient.ModelProvider
+	if !data.ID.IsNull() {
+		providerID := data.ID.ValueString()
+		tflog.Debug(ctx, fmt.Sprintf("Looking up model provider %s", providerID))
+
+		var err error
+		provider, err = d.client.GetModelProvider(ctx, providerID)
+		if err != nil {
+			if errors.Is(err, coraxclient.ErrNotFound) {
+				resp.Diagnostics.AddAttributeError(path.Root("id"), "Model Provider Not Found", fmt.Sprintf("No model provider with ID %q exists.", providerID))
+				return
+			}
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read model provider %s, got error: %s", providerID, err))
+			return
+		}
+	} else {
+		name := data.Name.ValueString()
+		tflog.Debug(ctx, fmt.Sprintf("Looking up model provider %q", name))
+
+		providers, err := d.client.ListModelProviders(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list model providers, got error: %s", err))
+			return
+		}
+
+		matches := findModelProvidersByName(providers, name)
+		switch len(matches) {
+		case 0:
+			resp.Diagnostics.AddAttributeError(path.Root("name"), "Model Provider Not Found", fmt.Sprintf("No model provider named %q exists.", name))
+			return
+		case 1:
+			provider = &matches[0]
+		default:
+			ids := make([]string, 0, len(matches))
+			for _, match := range matches {
+				ids = append(ids, match.ID)
+			}
+			resp.Diagnostics.AddAttributeError(path.Root("name"), "Ambiguous Model Provider Name",
+				fmt.Sprintf("%d model providers are named %q: %s. Look the model provider up by id instead.", len(matches), name, strings.Join(ids, ", ")))
+			return
+		}
+	}
+
+	fields := modelProviderConfigurationFields(ctx, d.client, provider.ProviderType)
+	data = mapModelProviderToModel(ctx, provider, fields, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,107 @@
+// Copyright (c) Trifork
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-corax/internal/coraxclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ModelProviderTypeDataSource{}
+
+func NewModelProviderTypeDataSource() datasource.DataSource {
+	return &ModelProviderTypeDataSource{}
+}
+
+// ModelProviderTypeDataSource defines the data source implementation.
+// Its data model is ModelProviderTypeModel, shared with the corax_model_provider_types data source.
+type ModelProviderTypeDataSource struct {
+	client *coraxclient.Client
+}
+
+func (d *ModelProviderTypeDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_model_provider_type"
+}
+
+func (d *ModelProviderTypeDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := modelProviderTypeSchemaAttributes()
+	attributes["name"] = schema.StringAttribute{
+		Required:            true,
+		MarkdownDescription: "The name of the provider type to look up, e.g. `openai`.",
+		Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads a model provider type with the configuration fields of its model providers and model deployments, " +
+			"e.g. to document the keys of `corax_model_provider.configuration`.",
+		Attributes: attributes,
+	}
+}
+
+func (d *ModelProviderTypeDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*coraxclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *coraxclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *ModelProviderTypeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ModelProviderTypeModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := data.Name.ValueString()
+	tflog.Debug(ctx, fmt.Sprintf("Reading model provider type %q", name))
+
+	providerType, err := d.client.GetModelProviderType(ctx, name)
+	if err != nil {
+		if errors.Is(err, coraxclient.ErrNotFound) {
+			resp.Diagnostics.AddAttributeError(path.Root("name"), "Model Provider Type Not Found",
+				fmt.Sprintf("No model provider type named %q exists. Use the corax_model_provider_types data source to list the supported types.", name))
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read model provider type %s, got error: %s", name, err))
+		return
+	}
+
+	// The dedicated configuration endpoints are authoritative for the field lists.
+	configurationFields, err := d.client.GetModelProviderConfigurationFields(ctx, name)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read the configuration fields of model provider type %s, got error: %s", name, err))
+		return
+	}
+	deploymentConfigurationFields, err := d.client.GetModelDeploymentConfigurationFields(ctx, name)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read the deployment configuration fields of model provider type %s, got error: %s", name, err))
+		return
+	}
+	providerType.Configuration = configurationFields
+	providerType.ModelDeploymentConfiguration = deploymentConfigurationFields
+
+	data = mapModelProviderTypeToModel(ctx, providerType, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
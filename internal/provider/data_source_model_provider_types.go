@@ -0,0 +1,334 @@
+// Copyright (c) Trifork
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-corax/internal/coraxclient"
+	api "terraform-provider-corax/internal/generated"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ModelProviderTypesDataSource{}
+
+func NewModelProviderTypesDataSource() datasource.DataSource {
+	return &ModelProviderTypesDataSource{}
+}
+
+// ModelProviderTypesDataSource defines the data source implementation.
+type ModelProviderTypesDataSource struct {
+	client *coraxclient.Client
+}
+
+// ModelProviderTypesDataSourceModel describes the data source data model.
+type ModelProviderTypesDataSourceModel struct {
+	Names              types.List `tfsdk:"names"`                // List of strings
+	ModelProviderTypes types.List `tfsdk:"model_provider_types"` // List of ModelProviderTypeModel
+}
+
+// ModelProviderTypeModel maps to components.schemas.ModelProviderType.
+// It is also embedded in the data model of the corax_model_provider_type data source.
+type ModelProviderTypeModel struct {
+	Name                          types.String `tfsdk:"name"`
+	Label                         types.String `tfsdk:"label"`
+	Description                   types.String `tfsdk:"description"`                     // Nullable
+	IconURL                       types.String `tfsdk:"icon_url"`                        // Nullable
+	ConfigurationFields           types.List   `tfsdk:"configuration_fields"`            // List of ConfigurationFieldModel
+	DeploymentConfigurationFields types.List   `tfsdk:"deployment_configuration_fields"` // List of ConfigurationFieldModel
+}
+
+// ConfigurationFieldModel maps to components.schemas.ConfigurationField.
+type ConfigurationFieldModel struct {
+	Name        types.String  `tfsdk:"name"`
+	Label       types.String  `tfsdk:"label"`
+	Type        types.String  `tfsdk:"type"`
+	Required    types.Bool    `tfsdk:"required"`
+	Secret      types.Bool    `tfsdk:"secret"`      // Derived: type is key
+	Default     types.String  `tfsdk:"default"`     // Nullable; lists are JSON-encoded
+	Description types.String  `tfsdk:"description"` // Nullable
+	Placeholder types.String  `tfsdk:"placeholder"` // Nullable
+	Order       types.Int64   `tfsdk:"order"`       // Nullable
+	Pattern     types.String  `tfsdk:"pattern"`     // Nullable
+	Min         types.Float64 `tfsdk:"min"`         // Nullable
+	Max         types.Float64 `tfsdk:"max"`         // Nullable
+	Step        types.Float64 `tfsdk:"step"`        // Nullable
+}
+
+func configurationFieldAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"name":        types.StringType,
+		"label":       types.StringType,
+		"type":        types.StringType,
+		"required":    types.BoolType,
+		"secret":      types.BoolType,
+		"default":     types.StringType,
+		"description": types.StringType,
+		"placeholder": types.StringType,
+		"order":       types.Int64Type,
+		"pattern":     types.StringType,
+		"min":         types.Float64Type,
+		"max":         types.Float64Type,
+		"step":        types.Float64Type,
+	}
+}
+
+func modelProviderTypeAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"name":                            types.StringType,
+		"label":                           types.StringType,
+		"description":                     types.StringType,
+		"icon_url":                        types.StringType,
+		"configuration_fields":            types.ListType{ElemType: types.ObjectType{AttrTypes: configurationFieldAttributeTypes()}},
+		"deployment_configuration_fields": types.ListType{ElemType: types.ObjectType{AttrTypes: configurationFieldAttributeTypes()}},
+	}
+}
+
+// configurationFieldSchemaAttributes returns the computed attributes of a configuration field.
+func configurationFieldSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The configuration key, e.g. `api_key`.",
+		},
+		"label": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The display label of the field.",
+		},
+		"type": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The type of the field: `text`, `number`, `boolean`, `url`, `key` or `string_array`.",
+		},
+		"required": schema.BoolAttribute{
+			Computed:            true,
+			MarkdownDescription: "Whether the field must be set. Required fields with a default may be omitted.",
+		},
+		"secret": schema.BoolAttribute{
+			Computed:            true,
+			MarkdownDescription: "Whether the field holds a secret, i.e. its type is `key`. Secret values are redacted by the model provider data sources.",
+		},
+		"default": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The default value of the field, if any. List defaults are JSON-encoded.",
+		},
+		"description": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The description of the field, if any.",
+		},
+		"placeholder": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "An example value for the field, if any.",
+		},
+		"order": schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: "The display order of the field, if set.",
+		},
+		"pattern": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The regular expression string values must match, if any.",
+		},
+		"min": schema.Float64Attribute{
+			Computed:            true,
+			MarkdownDescription: "The minimum of a `number` field, if any.",
+		},
+		"max": schema.Float64Attribute{
+			Computed:            true,
+			MarkdownDescription: "The maximum of a `number` field, if any.",
+		},
+		"step": schema.Float64Attribute{
+			Computed:            true,
+			MarkdownDescription: "The step of a `number` field, if any.",
+		},
+	}
+}
+
+// modelProviderTypeSchemaAttributes returns the computed attributes of a model provider type, shared by corax_model_provider_types and corax_model_provider_type.
+func modelProviderTypeSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The name of the provider type, as used in `corax_model_provider.provider_type`.",
+		},
+		"label": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The display label of the provider type.",
+		},
+		"description": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The description of the provider type, if any.",
+		},
+		"icon_url": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The URL of the icon of the provider type, if any.",
+		},
+		"configuration_fields": schema.ListNestedAttribute{
+			Computed:            true,
+			MarkdownDescription: "The fields of `corax_model_provider.configuration` for this provider type.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: configurationFieldSchemaAttributes(),
+			},
+		},
+		"deployment_configuration_fields": schema.ListNestedAttribute{
+			Computed:            true,
+			MarkdownDescription: "The fields of `corax_model_deployment.configuration` for deployments of providers of this type.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: configurationFieldSchemaAttributes(),
+			},
+		},
+	}
+}
+
+// configurationFieldBoundValue converts a min/max/step bound to a types.Float64, or null when absent.
+func configurationFieldBoundValue(f *float32, i *int32) types.Float64 {
+	if bound, set := configurationFieldBound(f, i); set {
+		return types.Float64Value(bound)
+	}
+	return types.Float64Null()
+}
+
+// configurationFieldDefaultValue converts the default of a field to a string: strings are kept, lists are JSON-encoded.
+func configurationFieldDefaultValue(def *api.Default, diags *diag.Diagnostics) types.String {
+	switch {
+	case def == nil:
+		return types.StringNull()
+	case def.String != nil:
+		return types.StringValue(*def.String)
+	case def.ArrayOfAny != nil:
+		jsonBytes, err := json.Marshal(*def.ArrayOfAny)
+		if err != nil {
+			diags.AddError("JSON Conversion Error", fmt.Sprintf("Failed to marshal configuration field default to JSON: %s", err))
+			return types.StringNull()
+		}
+		return types.StringValue(string(jsonBytes))
+	}
+	return types.StringNull()
+}
+
+// mapConfigurationFieldsToList maps API configuration fields to a list of ConfigurationFieldModel.
+func mapConfigurationFieldsToList(ctx context.Context, fields []api.ConfigurationField, diags *diag.Diagnostics) types.List {
+	models := make([]ConfigurationFieldModel, 0, len(fields))
+	for _, field := range fields {
+		model := ConfigurationFieldModel{
+			Name:        types.StringValue(field.Name),
+			Label:       types.StringValue(field.Label),
+			Type:        types.StringValue(string(field.Type)),
+			Required:    types.BoolValue(field.Required),
+			Secret:      types.BoolValue(field.Type == api.FIELD_TYPE_KEY),
+			Default:     configurationFieldDefaultValue(field.Default.Get(), diags),
+			Description: types.StringPointerValue(field.Description.Get()),
+			Placeholder: types.StringPointerValue(field.Placeholder.Get()),
+			Order:       optionalInt32Value(field.Order),
+			Pattern:     types.StringPointerValue(field.Pattern.Get()),
+			Min:         types.Float64Null(),
+			Max:         types.Float64Null(),
+			Step:        types.Float64Null(),
+		}
+		if min := field.Min.Get(); min != nil {
+			model.Min = configurationFieldBoundValue(min.Float32, min.Int32)
+		}
+		if max := field.Max.Get(); max != nil {
+			model.Max = configurationFieldBoundValue(max.Float32, max.Int32)
+		}
+		if step := field.Step.Get(); step != nil {
+			model.Step = configurationFieldBoundValue(step.Float32, step.Int32)
+		}
+		models = append(models, model)
+	}
+
+	list, d := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: configurationFieldAttributeTypes()}, models)
+	diags.Append(d...)
+	return list
+}
+
+// mapModelProviderTypeToModel maps an api.ModelProviderType to the Terraform model.
+func mapModelProviderTypeToModel(ctx context.Context, providerType *api.ModelProviderType, diags *diag.Diagnostics) ModelProviderTypeModel {
+	return ModelProviderTypeModel{
+		Name:                          types.StringValue(providerType.Name),
+		Label:                         types.StringValue(providerType.Label),
+		Description:                   types.StringPointerValue(providerType.Description.Get()),
+		IconURL:                       types.StringPointerValue(providerType.IconUrl.Get()),
+		ConfigurationFields:           mapConfigurationFieldsToList(ctx, providerType.Configuration, diags),
+		DeploymentConfigurationFields: mapConfigurationFieldsToList(ctx, providerType.ModelDeploymentConfiguration, diags),
+	}
+}
+
+func (d *ModelProviderTypesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_model_provider_types"
+}
+
+func (d *ModelProviderTypesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the model provider types supported by Corax, with the configuration fields of their model providers and model deployments.",
+		Attributes: map[string]schema.Attribute{
+			"names": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The names of the provider types, for use in `corax_model_provider.provider_type`.",
+			},
+			"model_provider_types": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The provider types.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: modelProviderTypeSchemaAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *ModelProviderTypesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*coraxclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *coraxclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *ModelProviderTypesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ModelProviderTypesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Listing model provider types")
+	providerTypes, err := d.client.ListModelProviderTypes(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list model provider types, got error: %s", err))
+		return
+	}
+
+	names := make([]string, 0, len(providerTypes))
+	models := make([]ModelProviderTypeModel, 0, len(providerTypes))
+	for i := range providerTypes {
+		names = append(names, providerTypes[i].Name)
+		models = append(models, mapModelProviderTypeToModel(ctx, &providerTypes[i], &resp.Diagnostics))
+	}
+	namesVal, diags := types.ListValueFrom(ctx, types.StringType, names)
+	resp.Diagnostics.Append(diags...)
+	providerTypesVal, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: modelProviderTypeAttributeTypes()}, models)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Names = namesVal
+	data.ModelProviderTypes = providerTypesVal
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
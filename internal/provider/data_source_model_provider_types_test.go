@@ -0,0 +1,86 @@
+// Copyright (c) Trifork
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	api "terraform-provider-corax/internal/generated"
+)
+
+func TestAccModelProviderTypesDataSource_basic(t *testing.T) {
+	if os.Getenv("CORAX_API_ENDPOINT") == "" || os.Getenv("CORAX_API_KEY") == "" {
+		t.Skip("Skipping acceptance test: CORAX_API_ENDPOINT or CORAX_API_KEY not set")
+	}
+	providerType := os.Getenv("CORAX_TEST_MODEL_PROVIDER_TYPE")
+	if providerType == "" {
+		t.Skip("Skipping acceptance test: CORAX_TEST_MODEL_PROVIDER_TYPE must be set with a valid provider type (e.g., 'azure_openai', 'openai')")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccModelProviderTypesDataSourceConfig(providerType),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr("data.corax_model_provider_types.test", "names.*", providerType),
+					resource.TestCheckResourceAttrSet("data.corax_model_provider_type.test", "label"),
+					resource.TestCheckResourceAttrSet("data.corax_model_provider_type.test", "configuration_fields.0.name"),
+					resource.TestCheckResourceAttrSet("data.corax_model_provider_type.test", "deployment_configuration_fields.0.name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccModelProviderTypesDataSourceConfig(providerType string) string {
+	return fmt.Sprintf(`
+provider "corax" {}
+
+data "corax_model_provider_types" "test" {}
+
+data "corax_model_provider_type" "test" {
+  name = %q
+}
+`, providerType)
+}
+
+func TestMapConfigurationFieldsToList(t *testing.T) {
+	min := api.Min{Int32: api.PtrInt32(0)}
+	max := api.Max{Float32: api.PtrFloat32(0.1)}
+	fields := []api.ConfigurationField{
+		{Name: "api_key", Label: "API Key", Type: api.FIELD_TYPE_KEY, Required: true},
+		{Name: "temperature", Label: "Temperature", Type: api.FIELD_TYPE_NUMBER, Min: *api.NewNullableMin(&min), Max: *api.NewNullableMax(&max)},
+		{Name: "scopes", Label: "Scopes", Type: api.FIELD_TYPE_STRING_ARRAY, Default: *api.NewNullableDefault(&api.Default{ArrayOfAny: &[]interface{}{"read", "write"}})},
+		{Name: "region", Label: "Region", Type: api.FIELD_TYPE_TEXT, Default: *api.NewNullableDefault(&api.Default{String: api.PtrString("eu")})},
+	}
+
+	var diags diag.Diagnostics
+	list := mapConfigurationFieldsToList(context.Background(), fields, &diags)
+	if diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+
+	var models []ConfigurationFieldModel
+	diags.Append(list.ElementsAs(context.Background(), &models, false)...)
+	if diags.HasError() || len(models) != 4 {
+		t.Fatalf("Unexpected fields %v: %v", models, diags)
+	}
+
+	if !models[0].Secret.ValueBool() || models[1].Secret.ValueBool() {
+		t.Errorf("Expected only the key field to be secret")
+	}
+	if models[1].Min.ValueFloat64() != 0 || models[1].Max.ValueFloat64() != 0.1 || !models[1].Step.IsNull() {
+		t.Errorf("Unexpected bounds: min %v, max %v, step %v", models[1].Min, models[1].Max, models[1].Step)
+	}
+	if models[2].Default.ValueString() != `["read","write"]` || models[3].Default.ValueString() != "eu" || !models[0].Default.IsNull() {
+		t.Errorf("Unexpected defaults: %v, %v, %v", models[2].Default, models[3].Default, models[0].Default)
+	}
+}
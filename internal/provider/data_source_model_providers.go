@@ -0,0 +1,232 @@
+// Copyright (c) Trifork
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-corax/internal/coraxclient"
+	api "terraform-provider-corax/internal/generated"
+)
+
+// redactedConfigurationValue replaces the values of secret model provider configuration fields.
+const redactedConfigurationValue = "REDACTED"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ModelProvidersDataSource{}
+
+func NewModelProvidersDataSource() datasource.DataSource {
+	return &ModelProvidersDataSource{}
+}
+
+// ModelProvidersDataSource defines the data source implementation.
+type ModelProvidersDataSource struct {
+	client *coraxclient.Client
+}
+
+// ModelProvidersDataSourceModel describes the data source data model.
+type ModelProvidersDataSourceModel struct {
+	ProviderType   types.String `tfsdk:"provider_type"`   // Optional filter
+	ModelProviders types.List   `tfsdk:"model_providers"` // List of ModelProviderModel
+}
+
+// ModelProviderModel maps to components.schemas.ModelProvider, with secret configuration values redacted.
+// It is also embedded in the data model of the corax_model_provider data source.
+type ModelProviderModel struct {
+	ID            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	ProviderType  types.String `tfsdk:"provider_type"`
+	Configuration types.Map    `tfsdk:"configuration"` // Map of strings, secrets redacted
+	CreatedBy     types.String `tfsdk:"created_by"`
+	CreatedAt     types.String `tfsdk:"created_at"` // RFC3339
+	UpdatedBy     types.String `tfsdk:"updated_by"` // Nullable
+	UpdatedAt     types.String `tfsdk:"updated_at"` // Nullable, RFC3339
+}
+
+func modelProviderAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":            types.StringType,
+		"name":          types.StringType,
+		"provider_type": types.StringType,
+		"configuration": types.MapType{ElemType: types.StringType},
+		"created_by":    types.StringType,
+		"created_at":    types.StringType,
+		"updated_by":    types.StringType,
+		"updated_at":    types.StringType,
+	}
+}
+
+// modelProviderSchemaAttributes returns the computed attributes of a model provider, shared by corax_model_providers and corax_model_provider.
+func modelProviderSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The UUID of the model provider.",
+		},
+		"name": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The name of the model provider.",
+		},
+		"provider_type": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The type of the model provider, e.g. `openai` or `azure_openai`.",
+		},
+		"configuration": schema.MapAttribute{
+			Computed:    true,
+			Sensitive:   true,
+			ElementType: types.StringType,
+			MarkdownDescription: "The configuration of the model provider. The values of secret fields, such as `api_key`, are replaced by `" + redactedConfigurationValue + "`. " +
+				"If the fields of the provider type cannot be read, every value is redacted.",
+		},
+		"created_by": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The ID of the user who created the model provider.",
+		},
+		"created_at": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The date and time the model provider was created (RFC3339 format).",
+		},
+		"updated_by": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The ID of the user who last updated the model provider, if it was updated.",
+		},
+		"updated_at": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The date and time the model provider was last updated (RFC3339 format), if it was updated.",
+		},
+	}
+}
+
+// redactModelProviderConfiguration returns a copy of config in which the values of secret fields, those of type key,
+// are replaced by redactedConfigurationValue. The api_key field is always treated as secret. When fields is nil the
+// secret fields are unknown, and every value is redacted.
+func redactModelProviderConfiguration(config map[string]string, fields []api.ConfigurationField) map[string]string {
+	secret := map[string]bool{apiKeyConfigurationKey: true}
+	for _, field := range fields {
+		if field.Type == api.FIELD_TYPE_KEY {
+			secret[field.Name] = true
+		}
+	}
+
+	redacted := make(map[string]string, len(config))
+	for key, value := range config {
+		if fields == nil || secret[key] {
+			value = redactedConfigurationValue
+		}
+		redacted[key] = value
+	}
+	return redacted
+}
+
+// modelProviderConfigurationFields returns the configuration fields of providerType, which tell which fields are secret.
+// It returns nil when they can't be read, so that every configuration value is redacted.
+func modelProviderConfigurationFields(ctx context.Context, client *coraxclient.Client, providerType string) []api.ConfigurationField {
+	fields, err := client.CachedModelProviderConfigurationFields(ctx, providerType)
+	if err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("Unable to read the configuration fields of provider type %q, redacting every configuration value: %s", providerType, err))
+		return nil
+	}
+	if fields == nil {
+		return []api.ConfigurationField{}
+	}
+	return fields
+}
+
+// mapModelProviderToModel maps a coraxclient.ModelProvider to the Terraform model, redacting secret configuration values.
+func mapModelProviderToModel(ctx context.Context, provider *coraxclient.ModelProvider, fields []api.ConfigurationField, diags *diag.Diagnostics) ModelProviderModel {
+	configuration, d := types.MapValueFrom(ctx, types.StringType, redactModelProviderConfiguration(provider.Configuration, fields))
+	diags.Append(d...)
+
+	return ModelProviderModel{
+		ID:            types.StringValue(provider.ID),
+		Name:          types.StringValue(provider.Name),
+		ProviderType:  types.StringValue(provider.ProviderType),
+		Configuration: configuration,
+		CreatedBy:     types.StringValue(provider.CreatedBy),
+		CreatedAt:     types.StringValue(provider.CreatedAt),
+		UpdatedBy:     types.StringPointerValue(provider.UpdatedBy),
+		UpdatedAt:     types.StringPointerValue(provider.UpdatedAt),
+	}
+}
+
+func (d *ModelProvidersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_model_providers"
+}
+
+func (d *ModelProvidersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the Corax model providers, optionally filtered by provider type. Secret configuration values are redacted.",
+		Attributes: map[string]schema.Attribute{
+			"provider_type": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only list model providers of this type, e.g. `openai`.",
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"model_providers": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The model providers matching the filter.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: modelProviderSchemaAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *ModelProvidersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*coraxclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *coraxclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *ModelProvidersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ModelProvidersDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Listing model providers")
+	providers, err := d.client.ListModelProviders(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list model providers, got error: %s", err))
+		return
+	}
+
+	providerType := data.ProviderType.ValueString()
+	models := []ModelProviderModel{}
+	for i := range providers {
+		if providerType != "" && providers[i].ProviderType != providerType {
+			continue
+		}
+		fields := modelProviderConfigurationFields(ctx, d.client, providers[i].ProviderType)
+		models = append(models, mapModelProviderToModel(ctx, &providers[i], fields, &resp.Diagnostics))
+	}
+	providersVal, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: modelProviderAttributeTypes()}, models)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ModelProviders = providersVal
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,124 @@
+// Copyright (c) Trifork
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	api "terraform-provider-corax/internal/generated"
+)
+
+func TestAccModelProvidersDataSource_basic(t *testing.T) {
+	if os.Getenv("CORAX_API_ENDPOINT") == "" || os.Getenv("CORAX_API_KEY") == "" {
+		t.Skip("Skipping acceptance test: CORAX_API_ENDPOINT or CORAX_API_KEY not set")
+	}
+	providerType := os.Getenv("CORAX_TEST_MODEL_PROVIDER_TYPE")
+	if providerType == "" {
+		t.Skip("Skipping acceptance test: CORAX_TEST_MODEL_PROVIDER_TYPE must be set with a valid provider type (e.g., 'azure_openai', 'openai')")
+	}
+
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	name := fmt.Sprintf("tf-acc-test-model-providers-%s", rName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccModelProvidersDataSourceConfig(name, providerType),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.corax_model_provider.by_name", "id", "corax_model_provider.test", "id"),
+					resource.TestCheckResourceAttr("data.corax_model_provider.by_name", "configuration.api_key", redactedConfigurationValue),
+					resource.TestCheckResourceAttrPair("data.corax_model_provider.by_id", "name", "corax_model_provider.test", "name"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.corax_model_providers.test", "model_providers.*", map[string]string{
+						"name":                  name,
+						"configuration.api_key": redactedConfigurationValue,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccModelProvidersDataSourceConfig(name string, providerType string) string {
+	return fmt.Sprintf(`
+provider "corax" {}
+
+resource "corax_model_provider" "test" {
+  name          = %[1]q
+  provider_type = %[2]q
+  configuration = {
+    api_key      = "test-api-key"
+    api_endpoint = "https://example-azure.openai.com/"
+  }
+}
+
+data "corax_model_providers" "test" {
+  provider_type = %[2]q
+  depends_on    = [corax_model_provider.test]
+}
+
+data "corax_model_provider" "by_name" {
+  name = corax_model_provider.test.name
+}
+
+data "corax_model_provider" "by_id" {
+  id = corax_model_provider.test.id
+}
+`, name, providerType)
+}
+
+func TestRedactModelProviderConfiguration(t *testing.T) {
+	config := map[string]string{
+		"api_key":       "sk-...abcd",
+		"client_secret": "secret",
+		"api_endpoint":  "https://example.openai.azure.com/",
+	}
+	fields := []api.ConfigurationField{
+		{Name: "client_secret", Type: api.FIELD_TYPE_KEY},
+		{Name: "api_endpoint", Type: api.FIELD_TYPE_URL},
+	}
+
+	tests := []struct {
+		name   string
+		fields []api.ConfigurationField
+		want   map[string]string
+	}{
+		{
+			name:   "secret fields",
+			fields: fields,
+			want: map[string]string{
+				"api_key":       redactedConfigurationValue,
+				"client_secret": redactedConfigurationValue,
+				"api_endpoint":  "https://example.openai.azure.com/",
+			},
+		},
+		{
+			name:   "unknown fields",
+			fields: nil,
+			want: map[string]string{
+				"api_key":       redactedConfigurationValue,
+				"client_secret": redactedConfigurationValue,
+				"api_endpoint":  redactedConfigurationValue,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := redactModelProviderConfiguration(config, tt.fields)
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("redactModelProviderConfiguration() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if config["api_key"] != "sk-...abcd" {
+		t.Errorf("redactModelProviderConfiguration() modified its input: %v", config)
+	}
+}
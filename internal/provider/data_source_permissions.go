@@ -0,0 +1,138 @@
+// Copyright (c) Trifork
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-corax/internal/coraxclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &PermissionsDataSource{}
+
+func NewPermissionsDataSource() datasource.DataSource {
+	return &PermissionsDataSource{}
+}
+
+// PermissionsDataSource defines the data source implementation.
+type PermissionsDataSource struct {
+	client *coraxclient.Client
+}
+
+// PermissionsDataSourceModel describes the data source data model.
+type PermissionsDataSourceModel struct {
+	IDs         types.List `tfsdk:"ids"`         // List of strings
+	Permissions types.List `tfsdk:"permissions"` // List of PermissionModel
+}
+
+// PermissionModel maps to components.schemas.PermissionItem.
+type PermissionModel struct {
+	ID          types.String `tfsdk:"id"`
+	Description types.String `tfsdk:"description"`
+	Category    types.String `tfsdk:"category"`
+}
+
+func permissionAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":          types.StringType,
+		"description": types.StringType,
+		"category":    types.StringType,
+	}
+}
+
+func (d *PermissionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_permissions"
+}
+
+func (d *PermissionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the permissions that can be granted to a Corax role.",
+		Attributes: map[string]schema.Attribute{
+			"ids": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The permission identifiers, e.g. `projects:read`, for use in `corax_role.permissions`.",
+			},
+			"permissions": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The permissions with their description and category.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The permission identifier, e.g. `projects:read`.",
+						},
+						"description": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "What the permission allows.",
+						},
+						"category": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The category the permission belongs to, e.g. `projects`.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *PermissionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*coraxclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *coraxclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *PermissionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PermissionsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Listing permissions")
+	permissions, err := d.client.ListPermissions(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list permissions, got error: %s", err))
+		return
+	}
+
+	ids := make([]string, 0, len(permissions))
+	models := make([]PermissionModel, 0, len(permissions))
+	for _, p := range permissions {
+		ids = append(ids, p.Id)
+		models = append(models, PermissionModel{
+			ID:          types.StringValue(p.Id),
+			Description: types.StringValue(p.Description),
+			Category:    types.StringValue(p.Category),
+		})
+	}
+	idsVal, diags := types.ListValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	permissionsVal, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: permissionAttributeTypes()}, models)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.IDs = idsVal
+	data.Permissions = permissionsVal
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
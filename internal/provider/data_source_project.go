@@ -0,0 +1,140 @@
+// Copyright (c) Trifork
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-corax/internal/coraxclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ProjectDataSource{}
+
+func NewProjectDataSource() datasource.DataSource {
+	return &ProjectDataSource{}
+}
+
+// ProjectDataSource defines the data source implementation.
+// Its data model is ProjectResourceModel, shared with the corax_project resource.
+type ProjectDataSource struct {
+	client *coraxclient.Client
+}
+
+// findProjectsByName returns the projects whose name equals name exactly.
+func findProjectsByName(projects []coraxclient.Project, name string) []coraxclient.Project {
+	var matches []coraxclient.Project
+	for _, project := range projects {
+		if project.Name == name {
+			matches = append(matches, project)
+		}
+	}
+	return matches
+}
+
+func (d *ProjectDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project"
+}
+
+func (d *ProjectDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := projectSchemaAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "The UUID of the project to look up. Exactly one of `id` and `name` must be set.",
+		Validators: []validator.String{
+			uuidValidator(),
+			stringvalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+		},
+	}
+	attributes["name"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "The exact name of the project to look up. The lookup fails when several visible projects have this name. Exactly one of `id` and `name` must be set.",
+		Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up a Corax project by ID or exact name.",
+		Attributes:          attributes,
+	}
+}
+
+func (d *ProjectDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*coraxclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *coraxclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *ProjectDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ProjectResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var project *coraxclient.Project
+	if !data.ID.IsNull() {
+		projectID := data.ID.ValueString()
+		tflog.Debug(ctx, fmt.Sprintf("Looking up project %s", projectID))
+
+		var err error
+		project, err = d.client.GetProject(ctx, projectID)
+		if err != nil {
+			if errors.Is(err, coraxclient.ErrNotFound) {
+				resp.Diagnostics.AddAttributeError(path.Root("id"), "Project Not Found", fmt.Sprintf("No project with ID %q exists or is visible to the caller.", projectID))
+				return
+			}
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project %s, got error: %s", projectID, err))
+			return
+		}
+	} else {
+		name := data.Name.ValueString()
+		tflog.Debug(ctx, fmt.Sprintf("Looking up project %q", name))
+
+		projects, err := d.client.ListProjects(ctx, "", "")
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list projects, got error: %s", err))
+			return
+		}
+
+		matches := findProjectsByName(projects, name)
+		switch len(matches) {
+		case 0:
+			resp.Diagnostics.AddAttributeError(path.Root("name"), "Project Not Found", fmt.Sprintf("No project named %q exists or is visible to the caller.", name))
+			return
+		case 1:
+			project = &matches[0]
+		default:
+			ids := make([]string, 0, len(matches))
+			for _, match := range matches {
+				ids = append(ids, match.ID)
+			}
+			resp.Diagnostics.AddAttributeError(path.Root("name"), "Ambiguous Project Name",
+				fmt.Sprintf("%d projects are named %q: %s. Look the project up by id instead.", len(matches), name, strings.Join(ids, ", ")))
+			return
+		}
+	}
+
+	mapProjectToModel(project, &data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
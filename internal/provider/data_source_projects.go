@@ -0,0 +1,173 @@
+// Copyright (c) Trifork
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-corax/internal/coraxclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ProjectsDataSource{}
+
+// listFilterRegexp matches the filter syntax of the paged list endpoints: field::value conditions separated by |.
+var listFilterRegexp = regexp.MustCompile(`^(.+::[^|]+)(\|.+::[^|]+)*$`)
+
+func NewProjectsDataSource() datasource.DataSource {
+	return &ProjectsDataSource{}
+}
+
+// ProjectsDataSource defines the data source implementation.
+// Projects are described by ProjectResourceModel, shared with the corax_project resource and data source.
+type ProjectsDataSource struct {
+	client *coraxclient.Client
+}
+
+// ProjectsDataSourceModel describes the data source data model.
+type ProjectsDataSourceModel struct {
+	Filter   types.String `tfsdk:"filter"`   // Optional, passed to the API
+	Sort     types.String `tfsdk:"sort"`     // Optional, passed to the API
+	Projects types.List   `tfsdk:"projects"` // List of ProjectResourceModel
+}
+
+func projectAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":               types.StringType,
+		"name":             types.StringType,
+		"description":      types.StringType,
+		"is_public":        types.BoolType,
+		"created_by":       types.StringType,
+		"created_at":       types.StringType,
+		"owner":            types.StringType,
+		"collection_count": types.Int64Type,
+		"capability_count": types.Int64Type,
+	}
+}
+
+// projectSchemaAttributes returns the computed attributes of a project, shared by corax_projects and corax_project.
+func projectSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The UUID of the project.",
+		},
+		"name": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The name of the project.",
+		},
+		"description": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The description of the project, if any.",
+		},
+		"is_public": schema.BoolAttribute{
+			Computed:            true,
+			MarkdownDescription: "Whether the project is public.",
+		},
+		"created_by": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The ID of the user who created the project.",
+		},
+		"created_at": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The date and time the project was created (RFC3339 format).",
+		},
+		"owner": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The owner of the project.",
+		},
+		"collection_count": schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: "The number of collections in the project.",
+		},
+		"capability_count": schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: "The number of capabilities in the project.",
+		},
+	}
+}
+
+func (d *ProjectsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_projects"
+}
+
+func (d *ProjectsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the Corax projects visible to the caller: projects they own and public projects.",
+		Attributes: map[string]schema.Attribute{
+			"filter": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "A filter passed to the API, as `field::value` conditions separated by `|`, e.g. `is_public::true`. " +
+					"How values are matched is defined by the API.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(listFilterRegexp, "must be field::value conditions separated by |"),
+				},
+			},
+			"sort": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The field the API sorts the projects by, e.g. `name`. Defaults to `id`.",
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"projects": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The projects.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: projectSchemaAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *ProjectsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*coraxclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *coraxclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *ProjectsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ProjectsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Listing projects")
+	projects, err := d.client.ListProjects(ctx, data.Filter.ValueString(), data.Sort.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list projects, got error: %s", err))
+		return
+	}
+
+	models := make([]ProjectResourceModel, len(projects))
+	for i := range projects {
+		mapProjectToModel(&projects[i], &models[i])
+	}
+	projectsVal, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: projectAttributeTypes()}, models)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Projects = projectsVal
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,103 @@
+// Copyright (c) Trifork
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-corax/internal/coraxclient"
+)
+
+func TestAccProjectsDataSource_basic(t *testing.T) {
+	if os.Getenv("CORAX_API_ENDPOINT") == "" || os.Getenv("CORAX_API_KEY") == "" {
+		t.Skip("Skipping acceptance test: CORAX_API_ENDPOINT or CORAX_API_KEY not set")
+	}
+
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	name := fmt.Sprintf("tf-acc-test-projects-%s", rName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectsDataSourceConfig(name, name+"-b"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.corax_project.by_name", "id", "corax_project.a", "id"),
+					resource.TestCheckResourceAttrPair("data.corax_project.by_id", "name", "corax_project.b", "name"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.corax_projects.test", "projects.*", map[string]string{"name": name}),
+				),
+			},
+			// Two projects with the same name make the name lookup ambiguous
+			{
+				Config:      testAccProjectsDataSourceConfig(name, name),
+				ExpectError: regexp.MustCompile("Ambiguous Project Name"),
+			},
+		},
+	})
+}
+
+func testAccProjectsDataSourceConfig(nameA string, nameB string) string {
+	return fmt.Sprintf(`
+provider "corax" {}
+
+resource "corax_project" "a" {
+  name = %[1]q
+}
+
+resource "corax_project" "b" {
+  name = %[2]q
+}
+
+data "corax_projects" "test" {
+  sort       = "name"
+  depends_on = [corax_project.a, corax_project.b]
+}
+
+data "corax_project" "by_name" {
+  name       = corax_project.a.name
+  depends_on = [corax_project.b]
+}
+
+data "corax_project" "by_id" {
+  id = corax_project.b.id
+}
+`, nameA, nameB)
+}
+
+func TestFindProjectsByName(t *testing.T) {
+	projects := []coraxclient.Project{
+		{ID: "project-1", Name: "Shared"},
+		{ID: "project-2", Name: "shared"},
+		{ID: "project-3", Name: "Shared"},
+	}
+
+	if matches := findProjectsByName(projects, "shared"); len(matches) != 1 || matches[0].ID != "project-2" {
+		t.Errorf("Expected only project-2 to match exactly, got %+v", matches)
+	}
+	if matches := findProjectsByName(projects, "Shared"); len(matches) != 2 {
+		t.Errorf("Expected 2 matches, got %+v", matches)
+	}
+	if matches := findProjectsByName(projects, "Other"); len(matches) != 0 {
+		t.Errorf("Expected no matches, got %+v", matches)
+	}
+}
+
+func TestListFilterRegexp(t *testing.T) {
+	for _, filter := range []string{"name::Sales", "is_public::true|name::Sales"} {
+		if !listFilterRegexp.MatchString(filter) {
+			t.Errorf("Expected %q to be a valid filter", filter)
+		}
+	}
+	for _, filter := range []string{"Sales", "name:Sales", "name::Sales|", "name::"} {
+		if listFilterRegexp.MatchString(filter) {
+			t.Errorf("Expected %q to be an invalid filter", filter)
+		}
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright (c) Trifork
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-corax/internal/coraxclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &RolesDataSource{}
+
+func NewRolesDataSource() datasource.DataSource {
+	return &RolesDataSource{}
+}
+
+// RolesDataSource defines the data source implementation.
+// Roles are described by RoleResourceModel, shared with the corax_role resource and data source.
+type RolesDataSource struct {
+	client *coraxclient.Client
+}
+
+// RolesDataSourceModel describes the data source data model.
+type RolesDataSourceModel struct {
+	Roles types.List `tfsdk:"roles"` // List of RoleResourceModel
+}
+
+func roleAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":           types.StringType,
+		"name":         types.StringType,
+		"description":  types.StringType,
+		"permissions":  types.SetType{ElemType: types.StringType},
+		"is_built_in":  types.BoolType,
+		"is_superuser": types.BoolType,
+		"created_by":   types.StringType,
+		"created_at":   types.StringType,
+		"updated_at":   types.StringType,
+	}
+}
+
+// roleSchemaAttributes returns the computed attributes of a role, shared by corax_roles and corax_role.
+func roleSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The UUID of the role.",
+		},
+		"name": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The name of the role.",
+		},
+		"description": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The description of the role, if any.",
+		},
+		"permissions": schema.SetAttribute{
+			Computed:            true,
+			ElementType:         types.StringType,
+			MarkdownDescription: "The permissions granted by the role.",
+		},
+		"is_built_in": schema.BoolAttribute{
+			Computed:            true,
+			MarkdownDescription: "Whether the role is built into Corax.",
+		},
+		"is_superuser": schema.BoolAttribute{
+			Computed:            true,
+			MarkdownDescription: "Whether the role grants superuser access.",
+		},
+		"created_by": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The ID of the user who created the role, if any.",
+		},
+		"created_at": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The date and time the role was created (RFC3339 format).",
+		},
+		"updated_at": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The date and time the role was last updated (RFC3339 format).",
+		},
+	}
+}
+
+func (d *RolesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_roles"
+}
+
+func (d *RolesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists all Corax roles, built-in and custom.",
+		Attributes: map[string]schema.Attribute{
+			"roles": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The roles.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: roleSchemaAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *RolesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*coraxclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *coraxclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *RolesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RolesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Listing roles")
+	roles, err := d.client.ListRoles(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list roles, got error: %s", err))
+		return
+	}
+
+	models := make([]RoleResourceModel, len(roles))
+	for i := range roles {
+		mapRoleToModel(ctx, &roles[i], &models[i], &resp.Diagnostics)
+	}
+	rolesVal, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: roleAttributeTypes()}, models)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Roles = rolesVal
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,72 @@
+// Copyright (c) Trifork
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	api "terraform-provider-corax/internal/generated"
+)
+
+func TestAccRolesDataSource_basic(t *testing.T) {
+	if os.Getenv("CORAX_API_ENDPOINT") == "" || os.Getenv("CORAX_API_KEY") == "" {
+		t.Skip("Skipping acceptance test: CORAX_API_ENDPOINT or CORAX_API_KEY not set")
+	}
+
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	name := fmt.Sprintf("tf-acc-test-role-%s", rName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRolesDataSourceConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.corax_role.test", "id", "corax_role.test", "id"),
+					resource.TestCheckResourceAttr("data.corax_role.test", "permissions.#", "2"),
+					resource.TestCheckResourceAttr("data.corax_role.test", "is_built_in", "false"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.corax_roles.test", "roles.*", map[string]string{"name": name}),
+					resource.TestCheckTypeSetElemAttr("data.corax_permissions.test", "ids.*", "projects:read"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRolesDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+provider "corax" {}
+
+data "corax_permissions" "test" {}
+
+resource "corax_role" "test" {
+  name        = %[1]q
+  permissions = ["projects:read", "capabilities:read"]
+}
+
+data "corax_roles" "test" {
+  depends_on = [corax_role.test]
+}
+
+data "corax_role" "test" {
+  name = corax_role.test.name
+}
+`, name)
+}
+
+func TestFindRoleByName(t *testing.T) {
+	roles := []api.Role{{Id: "role-1", Name: "Viewer"}, {Id: "role-2", Name: "Admin"}}
+
+	if role := findRoleByName(roles, "Admin"); role == nil || role.Id != "role-2" {
+		t.Errorf("Expected role-2, got %+v", role)
+	}
+	if role := findRoleByName(roles, "admin"); role != nil {
+		t.Errorf("Expected role names to match exactly, got %+v", role)
+	}
+}
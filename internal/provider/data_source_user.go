@@ -0,0 +1,127 @@
+// Copyright (c) Trifork
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-corax/internal/coraxclient"
+	api "terraform-provider-corax/internal/generated"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &UserDataSource{}
+
+func NewUserDataSource() datasource.DataSource {
+	return &UserDataSource{}
+}
+
+// UserDataSource defines the data source implementation.
+type UserDataSource struct {
+	client *coraxclient.Client
+}
+
+// UserDataSourceModel describes the data source data model.
+type UserDataSourceModel struct {
+	Email types.String `tfsdk:"email"` // Lookup key, matched against the username
+	UserModel
+}
+
+// findUser returns the user with the given ID, or else the user whose username equals the email,
+// ignoring case. It returns nil when no user matches.
+func findUser(users []api.UserRBAC, id string, email string) *api.UserRBAC {
+	for i := range users {
+		if id != "" && users[i].Id == id {
+			return &users[i]
+		}
+		if email != "" && strings.EqualFold(users[i].Username, email) {
+			return &users[i]
+		}
+	}
+	return nil
+}
+
+func (d *UserDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (d *UserDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := userSchemaAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "The ID of the user to look up. Exactly one of `id` and `email` must be set.",
+		Validators: []validator.String{
+			stringvalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("email")),
+		},
+	}
+	attributes["email"] = schema.StringAttribute{
+		Optional:            true,
+		MarkdownDescription: "The email address of the user to look up, matched case-insensitively against the username. Exactly one of `id` and `email` must be set.",
+		Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up a Corax user by ID or email address.",
+		Attributes:          attributes,
+	}
+}
+
+func (d *UserDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*coraxclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *coraxclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *UserDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data UserDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := data.ID.ValueString()
+	email := data.Email.ValueString()
+	tflog.Debug(ctx, fmt.Sprintf("Looking up user (id %q, email %q)", id, email))
+
+	users, err := d.client.ListUsers(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list users, got error: %s", err))
+		return
+	}
+
+	user := findUser(users, id, email)
+	if user == nil {
+		if id != "" {
+			resp.Diagnostics.AddAttributeError(path.Root("id"), "User Not Found", fmt.Sprintf("No user with ID %q exists.", id))
+		} else {
+			resp.Diagnostics.AddAttributeError(path.Root("email"), "User Not Found", fmt.Sprintf("No user with email %q exists.", email))
+		}
+		return
+	}
+
+	data.UserModel = mapUserToModel(ctx, user, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,209 @@
+// Copyright (c) Trifork
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-corax/internal/coraxclient"
+	api "terraform-provider-corax/internal/generated"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &UsersDataSource{}
+
+func NewUsersDataSource() datasource.DataSource {
+	return &UsersDataSource{}
+}
+
+// UsersDataSource defines the data source implementation.
+type UsersDataSource struct {
+	client *coraxclient.Client
+}
+
+// UsersDataSourceModel describes the data source data model.
+type UsersDataSourceModel struct {
+	Users types.List `tfsdk:"users"` // List of UserModel
+}
+
+// UserModel maps to components.schemas.UserRBAC.
+// It is also embedded in the data model of the corax_user data source.
+type UserModel struct {
+	ID            types.String `tfsdk:"id"`
+	Username      types.String `tfsdk:"username"`
+	Idp           types.String `tfsdk:"idp"` // Nullable
+	RoleID        types.String `tfsdk:"role_id"`
+	RoleName      types.String `tfsdk:"role_name"`
+	IsActive      types.Bool   `tfsdk:"is_active"`
+	IsSuperuser   types.Bool   `tfsdk:"is_superuser"`
+	Permissions   types.List   `tfsdk:"permissions"`    // List of strings
+	CreatedAt     types.String `tfsdk:"created_at"`     // RFC3339
+	DeactivatedAt types.String `tfsdk:"deactivated_at"` // Nullable, RFC3339
+	LastLoginAt   types.String `tfsdk:"last_login_at"`  // Nullable, RFC3339
+}
+
+func userAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":             types.StringType,
+		"username":       types.StringType,
+		"idp":            types.StringType,
+		"role_id":        types.StringType,
+		"role_name":      types.StringType,
+		"is_active":      types.BoolType,
+		"is_superuser":   types.BoolType,
+		"permissions":    types.ListType{ElemType: types.StringType},
+		"created_at":     types.StringType,
+		"deactivated_at": types.StringType,
+		"last_login_at":  types.StringType,
+	}
+}
+
+// userSchemaAttributes returns the computed attributes of a user, shared by corax_users and corax_user.
+func userSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The ID of the user.",
+		},
+		"username": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The username of the user, usually the email address from the identity provider.",
+		},
+		"idp": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The identity provider the user signs in with, if known.",
+		},
+		"role_id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The UUID of the role assigned to the user.",
+		},
+		"role_name": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The name of the role assigned to the user.",
+		},
+		"is_active": schema.BoolAttribute{
+			Computed:            true,
+			MarkdownDescription: "Whether the user is active.",
+		},
+		"is_superuser": schema.BoolAttribute{
+			Computed:            true,
+			MarkdownDescription: "Whether the user is a superuser.",
+		},
+		"permissions": schema.ListAttribute{
+			Computed:            true,
+			ElementType:         types.StringType,
+			MarkdownDescription: "The effective permissions of the user.",
+		},
+		"created_at": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The date and time the user was created (RFC3339 format).",
+		},
+		"deactivated_at": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The date and time the user was deactivated (RFC3339 format), if deactivated.",
+		},
+		"last_login_at": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The date and time the user last signed in (RFC3339 format), if ever.",
+		},
+	}
+}
+
+// optionalTimestampValue formats an optional API timestamp as RFC3339, or null when absent.
+func optionalTimestampValue(t *time.Time) types.String {
+	if t == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(t.Format(time.RFC3339))
+}
+
+// mapUserToModel maps an api.UserRBAC to the Terraform model.
+func mapUserToModel(ctx context.Context, user *api.UserRBAC, diags *diag.Diagnostics) UserModel {
+	permissions, d := types.ListValueFrom(ctx, types.StringType, enumStrings(user.Permissions))
+	diags.Append(d...)
+
+	return UserModel{
+		ID:            types.StringValue(user.Id),
+		Username:      types.StringValue(user.Username),
+		Idp:           types.StringPointerValue(user.Idp.Get()),
+		RoleID:        types.StringValue(user.RoleId),
+		RoleName:      types.StringValue(user.RoleName),
+		IsActive:      types.BoolValue(user.IsActive),
+		IsSuperuser:   types.BoolValue(user.GetIsSuperuser()),
+		Permissions:   permissions,
+		CreatedAt:     types.StringValue(user.CreatedAt.Format(time.RFC3339)),
+		DeactivatedAt: optionalTimestampValue(user.DeactivatedAt),
+		LastLoginAt:   optionalTimestampValue(user.LastLoginAt),
+	}
+}
+
+func (d *UsersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users"
+}
+
+func (d *UsersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists all Corax users with their role and permissions.",
+		Attributes: map[string]schema.Attribute{
+			"users": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The users.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: userSchemaAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *UsersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*coraxclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *coraxclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *UsersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data UsersDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Listing users")
+	users, err := d.client.ListUsers(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list users, got error: %s", err))
+		return
+	}
+
+	models := make([]UserModel, 0, len(users))
+	for i := range users {
+		models = append(models, mapUserToModel(ctx, &users[i], &resp.Diagnostics))
+	}
+	usersVal, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: userAttributeTypes()}, models)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Users = usersVal
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,93 @@
+// Copyright (c) Trifork
+
+package provider
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	api "terraform-provider-corax/internal/generated"
+)
+
+func TestAccUsersDataSource_basic(t *testing.T) {
+	if os.Getenv("CORAX_API_ENDPOINT") == "" || os.Getenv("CORAX_API_KEY") == "" {
+		t.Skip("Skipping acceptance test: CORAX_API_ENDPOINT or CORAX_API_KEY not set")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUsersDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.corax_users.test", "users.0.id"),
+					resource.TestCheckResourceAttrPair("data.corax_user.by_email", "id", "data.corax_users.test", "users.0.id"),
+					resource.TestCheckResourceAttrPair("data.corax_user.by_id", "username", "data.corax_users.test", "users.0.username"),
+					resource.TestCheckResourceAttrPair("data.corax_user.by_id", "role_id", "data.corax_users.test", "users.0.role_id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccUsersDataSourceConfig = `
+provider "corax" {}
+
+data "corax_users" "test" {}
+
+data "corax_user" "by_id" {
+  id = data.corax_users.test.users[0].id
+}
+
+data "corax_user" "by_email" {
+  email = upper(data.corax_users.test.users[0].username)
+}
+`
+
+func TestFindUser(t *testing.T) {
+	users := []api.UserRBAC{
+		{Id: "user-1", Username: "jane@example.com"},
+		{Id: "user-2", Username: "John@Example.com"},
+	}
+
+	if user := findUser(users, "user-2", ""); user == nil || user.Username != "John@Example.com" {
+		t.Errorf("Expected user-2 by ID, got %+v", user)
+	}
+	if user := findUser(users, "", "john@example.com"); user == nil || user.Id != "user-2" {
+		t.Errorf("Expected user-2 by email, got %+v", user)
+	}
+	if user := findUser(users, "", "jane"); user != nil {
+		t.Errorf("Expected no match for a partial email, got %+v", user)
+	}
+	if user := findUser(users, "user-3", ""); user != nil {
+		t.Errorf("Expected no match for an unknown ID, got %+v", user)
+	}
+}
+
+func TestMapUserToModel(t *testing.T) {
+	lastLogin := time.Date(2024, 2, 1, 8, 30, 0, 0, time.UTC)
+	user := api.UserRBAC{
+		Id: "user-1", Username: "jane@example.com", RoleId: "role-1", RoleName: "Viewer", IsActive: true,
+		Permissions: []api.Permission{api.PROJECTS_READ}, CreatedAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		LastLoginAt: &lastLogin,
+	}
+	var diags diag.Diagnostics
+
+	model := mapUserToModel(context.Background(), &user, &diags)
+
+	if diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+	if model.RoleName.ValueString() != "Viewer" || model.IsSuperuser.ValueBool() || len(model.Permissions.Elements()) != 1 {
+		t.Errorf("Unexpected model: %+v", model)
+	}
+	if !model.Idp.IsNull() || !model.DeactivatedAt.IsNull() || model.LastLoginAt.ValueString() != "2024-02-01T08:30:00Z" {
+		t.Errorf("Unexpected optional attributes: idp %s, deactivated_at %s, last_login_at %s", model.Idp, model.DeactivatedAt, model.LastLoginAt)
+	}
+}
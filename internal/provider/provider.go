@@ -4,6 +4,7 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"os"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -35,6 +36,7 @@ type CoraxProvider struct {
 type CoraxProviderModel struct {
 	APIEndpoint types.String `tfsdk:"api_endpoint"`
 	APIKey      types.String `tfsdk:"api_key"`
+	Preflight   types.Bool   `tfsdk:"preflight"`
 }
 
 func (p *CoraxProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -55,6 +57,12 @@ func (p *CoraxProvider) Schema(ctx context.Context, req provider.SchemaRequest,
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"preflight": schema.BoolAttribute{
+				MarkdownDescription: "Whether to check the API before planning: the provider calls the healthcheck, `/v1/auth/me` and `/features` " +
+					"endpoints and fails with a single error if the endpoint is unreachable or the API key is rejected. " +
+					"The discovered feature flags let resources report features that are disabled on the server. Defaults to false.",
+				Optional: true,
+			},
 		},
 	}
 }
@@ -116,6 +124,19 @@ func (p *CoraxProvider) Configure(ctx context.Context, req provider.ConfigureReq
 		return
 	}
 
+	if data.Preflight.ValueBool() {
+		me, err := client.Preflight(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Corax API Preflight Failed",
+				fmt.Sprintf("The provider could not verify the Corax API at %s: %s. "+
+					"Check the api_endpoint and api_key settings, or set preflight = false to skip these checks.", data.APIEndpoint.ValueString(), err),
+			)
+			return
+		}
+		tflog.Info(ctx, fmt.Sprintf("Corax API preflight succeeded, authenticated as %s with %d feature flags", me.Username, len(client.Features())))
+	}
+
 	resp.DataSourceData = client
 	resp.ResourceData = client
 	tflog.Info(ctx, "Corax API client configured successfully")
@@ -128,12 +149,23 @@ func (p *CoraxProvider) Resources(ctx context.Context) []func() resource.Resourc
 		NewChatCapabilityResource,             // Added Chat Capability
 		NewCompletionCapabilityResource,       // Added Completion Capability
 		NewSpeechToTextCapabilityResource,     // Added Speech-to-Text Capability
+		NewExtractionCapabilityResource,       // Added Extraction Capability
 		NewModelDeploymentResource,            // Added Model Deployment
 		NewModelProviderResource,              // Added Model Provider
 		NewCapabilityTypeDefaultModelResource, // Added Capability Type Default Model
 		NewMCPServerResource,                  // Added MCP Server
-		// NewCollectionResource, // Removed as per new scope
-		// NewDocumentResource,   // Removed as per new scope
+		NewKnowledgeCollectionResource,        // Added Knowledge Collection
+		NewKnowledgeDocumentResource,          // Added Knowledge Document
+		NewEvaluationDatasetResource,          // Added Evaluation Dataset
+		NewEvaluationDatasetItemResource,      // Added Evaluation Dataset Item
+		NewEvaluationDatasetItemsResource,     // Added Evaluation Dataset Items (bulk from file)
+		NewCapabilityEvaluationResource,       // Added Capability Evaluation
+		NewEvaluationRunResource,              // Added Evaluation Run
+		NewCompliancePolicyResource,           // Added Compliance Policy
+		NewCapabilityPolicyAttachmentResource, // Added Capability Policy Attachment
+		NewPolicyRolloutResource,              // Added Policy Rollout
+		NewRoleResource,                       // Added Role
+		NewUserRoleAssignmentResource,         // Added User Role Assignment
 		// NewEmbeddingsModelResource, // Removed as per new scope
 	}
 }
@@ -143,7 +175,31 @@ func (p *CoraxProvider) EphemeralResources(ctx context.Context) []func() ephemer
 }
 
 func (p *CoraxProvider) DataSources(ctx context.Context) []func() datasource.DataSource { // Updated receiver to CoraxProvider
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		NewGuardrailDryRunDataSource,    // Added Guardrail Dry Run
+		NewComplianceAssetsDataSource,   // Added Compliance Assets
+		NewComplianceAssetDataSource,    // Added Compliance Asset
+		NewComplianceBadgeDataSource,    // Added Compliance Badge
+		NewGuardrailsDataSource,         // Added Guardrails
+		NewGuardrailEventsDataSource,    // Added Guardrail Events
+		NewUsersDataSource,              // Added Users
+		NewUserDataSource,               // Added User
+		NewRolesDataSource,              // Added Roles
+		NewRoleDataSource,               // Added Role
+		NewPermissionsDataSource,        // Added Permissions
+		NewCurrentIdentityDataSource,    // Added Current Identity
+		NewFeaturesDataSource,           // Added Features
+		NewProjectsDataSource,           // Added Projects
+		NewProjectDataSource,            // Added Project
+		NewCapabilitiesDataSource,       // Added Capabilities
+		NewCapabilityDataSource,         // Added Capability
+		NewModelDeploymentsDataSource,   // Added Model Deployments
+		NewModelDeploymentDataSource,    // Added Model Deployment
+		NewModelProvidersDataSource,     // Added Model Providers
+		NewModelProviderDataSource,      // Added Model Provider
+		NewModelProviderTypesDataSource, // Added Model Provider Types
+		NewModelProviderTypeDataSource,  // Added Model Provider Type
+	}
 }
 
 func (p *CoraxProvider) Functions(ctx context.Context) []func() function.Function { // Updated receiver to CoraxProvider
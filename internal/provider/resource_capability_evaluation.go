@@ -0,0 +1,793 @@
+// Copyright (c) Trifork
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-corax/internal/coraxclient"
+	api "terraform-provider-corax/internal/generated"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CapabilityEvaluationResource{}
+var _ resource.ResourceWithImportState = &CapabilityEvaluationResource{}
+var _ resource.ResourceWithModifyPlan = &CapabilityEvaluationResource{}
+
+func NewCapabilityEvaluationResource() resource.Resource {
+	return &CapabilityEvaluationResource{}
+}
+
+// CapabilityEvaluationResource defines the resource implementation.
+type CapabilityEvaluationResource struct {
+	client *coraxclient.Client
+}
+
+// CapabilityEvaluationResourceModel describes the resource data model.
+// Based on components.schemas.CapabilityEvaluationRepresentation.
+type CapabilityEvaluationResourceModel struct {
+	ID                  types.String  `tfsdk:"id"`
+	Name                types.String  `tfsdk:"name"`
+	ProjectID           types.String  `tfsdk:"project_id"` // Nullable
+	IsPublic            types.Bool    `tfsdk:"is_public"`
+	CapabilityID        types.String  `tfsdk:"capability_id"`
+	CapabilityVersion   types.Int64   `tfsdk:"capability_version"` // Nullable
+	EvaluationDatasetID types.String  `tfsdk:"evaluation_dataset_id"`
+	Description         types.String  `tfsdk:"description"`       // configuration.description
+	SuccessThreshold    types.Float64 `tfsdk:"success_threshold"` // configuration.success_threshold
+	CreatedAt           types.String  `tfsdk:"created_at"`
+	CreatedBy           types.String  `tfsdk:"created_by"`
+	Criteria            types.List    `tfsdk:"criterion"` // List of CapabilityEvaluationCriterionModel
+}
+
+// CapabilityEvaluationCriterionModel maps to components.schemas.CapabilityEvaluationCriterionRepresentation.
+type CapabilityEvaluationCriterionModel struct {
+	ID                types.String `tfsdk:"id"`
+	Type              types.String `tfsdk:"type"`
+	Configuration     types.String `tfsdk:"configuration"` // JSON object string
+	ModelDeploymentID types.String `tfsdk:"model_deployment_id"`
+}
+
+func capabilityEvaluationCriterionAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":                  types.StringType,
+		"type":                types.StringType,
+		"configuration":       types.StringType,
+		"model_deployment_id": types.StringType,
+	}
+}
+
+// jsonObjectSubsetAPIToString is like jsonObjectAPIToString, but keeps the current value as long as
+// every key it sets matches the API value. This tolerates defaults the API adds to the object.
+func jsonObjectSubsetAPIToString(current types.String, apiValue map[string]interface{}, diags *diag.Diagnostics) types.String {
+	if !current.IsNull() && !current.IsUnknown() {
+		var currentMap map[string]interface{}
+		if err := json.Unmarshal([]byte(current.ValueString()), &currentMap); err == nil {
+			normalized := normalizeJSONObject(apiValue)
+			subset := true
+			for key, value := range currentMap {
+				if apiVal, ok := normalized[key]; !ok || !reflect.DeepEqual(value, apiVal) {
+					subset = false
+					break
+				}
+			}
+			if subset {
+				return current
+			}
+		}
+	}
+	return jsonObjectAPIToString(types.StringNull(), apiValue, diags)
+}
+
+// capabilityEvaluationCriterionToAPI converts a criterion model to the API create/update payload.
+func capabilityEvaluationCriterionToAPI(criterion CapabilityEvaluationCriterionModel, attrPath path.Path, diags *diag.Diagnostics) api.CapabilityEvaluationCriterionCreate {
+	configuration := map[string]interface{}{}
+	if !criterion.Configuration.IsNull() && !criterion.Configuration.IsUnknown() {
+		if err := json.Unmarshal([]byte(criterion.Configuration.ValueString()), &configuration); err != nil {
+			diags.AddAttributeError(attrPath.AtName("configuration"), "Invalid JSON Object",
+				fmt.Sprintf("Failed to parse configuration as a JSON object: %s", err))
+		}
+		if configuration == nil {
+			configuration = map[string]interface{}{}
+		}
+	}
+
+	payload := api.NewCapabilityEvaluationCriterionCreate(api.CapabilityCriterionType(criterion.Type.ValueString()), configuration)
+	if !criterion.ModelDeploymentID.IsNull() && !criterion.ModelDeploymentID.IsUnknown() {
+		payload.SetModelDeploymentId(criterion.ModelDeploymentID.ValueString())
+	}
+	return *payload
+}
+
+// mapCapabilityEvaluationCriterionToModel maps an API criterion to the model, keeping the current
+// configuration string when the API value is compatible with it.
+func mapCapabilityEvaluationCriterionToModel(apiCriterion *api.CapabilityEvaluationCriterionRepresentation, current CapabilityEvaluationCriterionModel, diags *diag.Diagnostics) CapabilityEvaluationCriterionModel {
+	model := CapabilityEvaluationCriterionModel{
+		ID:            types.StringValue(apiCriterion.GetId()),
+		Type:          types.StringValue(string(apiCriterion.Type)),
+		Configuration: jsonObjectSubsetAPIToString(current.Configuration, apiCriterion.Configuration, diags),
+	}
+	if deploymentID, ok := apiCriterion.GetModelDeploymentIdOk(); ok && deploymentID != nil && *deploymentID != "" {
+		model.ModelDeploymentID = types.StringValue(*deploymentID)
+	} else {
+		model.ModelDeploymentID = types.StringNull()
+	}
+	return model
+}
+
+// capabilityEvaluationCriterionChanged reports whether the planned criterion differs from the one in state.
+func capabilityEvaluationCriterionChanged(planned, current CapabilityEvaluationCriterionModel) bool {
+	if !planned.Type.Equal(current.Type) {
+		return true
+	}
+	if !planned.ModelDeploymentID.IsUnknown() && !planned.ModelDeploymentID.Equal(current.ModelDeploymentID) {
+		return true
+	}
+	if planned.Configuration.IsUnknown() || planned.Configuration.Equal(current.Configuration) {
+		return false
+	}
+	var plannedMap, currentMap map[string]interface{}
+	if json.Unmarshal([]byte(planned.Configuration.ValueString()), &plannedMap) != nil ||
+		json.Unmarshal([]byte(current.Configuration.ValueString()), &currentMap) != nil {
+		return true
+	}
+	return !reflect.DeepEqual(plannedMap, currentMap)
+}
+
+// matchCapabilityEvaluationCriteria pairs the planned criteria with the existing ones. For each planned criterion it
+// returns the index of the existing criterion it corresponds to, or -1 for a new criterion. Criteria with a known ID
+// are matched by ID. The others are matched to an unchanged criterion first, and then to the first unmatched criterion
+// of the same type, so inserting or removing a criterion leaves the other criteria untouched.
+func matchCapabilityEvaluationCriteria(planned, current []CapabilityEvaluationCriterionModel) []int {
+	matches := make([]int, len(planned))
+	used := make([]bool, len(current))
+
+	byID := make(map[string]int, len(current))
+	for j, existing := range current {
+		if !existing.ID.IsNull() && !existing.ID.IsUnknown() {
+			byID[existing.ID.ValueString()] = j
+		}
+	}
+	for i, criterion := range planned {
+		matches[i] = -1
+		if criterion.ID.IsNull() || criterion.ID.IsUnknown() {
+			continue
+		}
+		if j, ok := byID[criterion.ID.ValueString()]; ok && !used[j] {
+			matches[i] = j
+			used[j] = true
+		}
+	}
+
+	match := func(same func(planned, current CapabilityEvaluationCriterionModel) bool) {
+		for i, criterion := range planned {
+			if matches[i] >= 0 {
+				continue
+			}
+			for j, existing := range current {
+				if !used[j] && same(criterion, existing) {
+					matches[i] = j
+					used[j] = true
+					break
+				}
+			}
+		}
+	}
+	match(func(planned, current CapabilityEvaluationCriterionModel) bool {
+		return !capabilityEvaluationCriterionChanged(planned, current)
+	})
+	match(func(planned, current CapabilityEvaluationCriterionModel) bool {
+		return planned.Type.Equal(current.Type)
+	})
+
+	return matches
+}
+
+// planCapabilityEvaluationCriteria sets the ID of each planned criterion to the ID of the existing criterion it
+// corresponds to, and to unknown for new criteria. A model deployment that isn't configured is taken from the
+// existing criterion. configured holds the criteria of the configuration, in the same order as planned.
+func planCapabilityEvaluationCriteria(planned, configured, current []CapabilityEvaluationCriterionModel) []CapabilityEvaluationCriterionModel {
+	result := make([]CapabilityEvaluationCriterionModel, len(planned))
+	known := true
+	for i, criterion := range planned {
+		result[i] = criterion
+		result[i].ID = types.StringUnknown()
+		if i < len(configured) && configured[i].ModelDeploymentID.IsNull() {
+			result[i].ModelDeploymentID = types.StringUnknown()
+		}
+		if criterion.Type.IsUnknown() || criterion.Configuration.IsUnknown() {
+			known = false
+		}
+	}
+	// Criteria can only be matched reliably once their values are known, otherwise they are matched at apply time.
+	if !known {
+		return result
+	}
+
+	for i, j := range matchCapabilityEvaluationCriteria(result, current) {
+		if j < 0 {
+			continue
+		}
+		result[i].ID = current[j].ID
+		if result[i].ModelDeploymentID.IsUnknown() {
+			result[i].ModelDeploymentID = current[j].ModelDeploymentID
+		}
+	}
+	return result
+}
+
+// mapCapabilityEvaluationToModel maps an api.CapabilityEvaluationRepresentation to the Terraform model. Criteria are mapped separately.
+func mapCapabilityEvaluationToModel(evaluation *api.CapabilityEvaluationRepresentation, model *CapabilityEvaluationResourceModel) {
+	model.ID = types.StringValue(evaluation.Id)
+	model.Name = types.StringValue(evaluation.Name)
+	if projectID, ok := evaluation.GetProjectIdOk(); ok && projectID != nil && *projectID != "" {
+		model.ProjectID = types.StringValue(*projectID)
+	} else {
+		model.ProjectID = types.StringNull()
+	}
+	model.IsPublic = types.BoolValue(evaluation.GetIsPublic())
+	model.CapabilityID = types.StringValue(evaluation.GetCapabilityId())
+	if version, ok := evaluation.GetCapabilityVersionOk(); ok && version != nil {
+		model.CapabilityVersion = types.Int64Value(int64(*version))
+	} else {
+		model.CapabilityVersion = types.Int64Null()
+	}
+	model.EvaluationDatasetID = types.StringValue(evaluation.GetEvaluationDatasetId())
+
+	if description, ok := evaluation.Configuration.GetDescriptionOk(); ok && description != nil && *description != "" {
+		model.Description = types.StringValue(*description)
+	} else {
+		model.Description = types.StringNull()
+	}
+	if threshold, ok := evaluation.Configuration.GetSuccessThresholdOk(); ok && threshold != nil {
+		model.SuccessThreshold = types.Float64Value(float32ToFloat64(*threshold))
+	} else {
+		model.SuccessThreshold = types.Float64Null()
+	}
+
+	model.CreatedAt = types.StringValue(evaluation.CreatedAt.Format(time.RFC3339))
+	model.CreatedBy = types.StringValue(evaluation.CreatedBy)
+}
+
+// capabilityEvaluationConfigurationToAPI builds the evaluation configuration from the model.
+func capabilityEvaluationConfigurationToAPI(model CapabilityEvaluationResourceModel) api.CapabilityEvaluationConfiguration {
+	config := api.NewCapabilityEvaluationConfiguration()
+	if !model.Description.IsNull() && !model.Description.IsUnknown() {
+		config.SetDescription(model.Description.ValueString())
+	}
+	if !model.SuccessThreshold.IsNull() && !model.SuccessThreshold.IsUnknown() {
+		config.SetSuccessThreshold(float32(model.SuccessThreshold.ValueFloat64()))
+	}
+	return *config
+}
+
+func (r *CapabilityEvaluationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_capability_evaluation"
+}
+
+func (r *CapabilityEvaluationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Corax Capability Evaluation, which evaluates a capability against an evaluation dataset using a set of criteria. " +
+			"Each `criterion` block is created, updated and deleted individually. Criterion configuration is validated against the criterion types published by the API during plan.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier for the evaluation (UUID).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the evaluation.",
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"project_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The UUID of the project this evaluation belongs to.",
+				Validators:          []validator.String{uuidValidator()},
+			},
+			"is_public": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true, // API defaults to false if not provided
+				MarkdownDescription: "Indicates whether the evaluation is public. Defaults to false.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"capability_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The UUID of the capability to evaluate.",
+				Validators:          []validator.String{uuidValidator()},
+			},
+			"capability_version": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The capability version to evaluate. If not set, the API decides which version is used.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"evaluation_dataset_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The UUID of the evaluation dataset to evaluate the capability against.",
+				Validators:          []validator.String{uuidValidator()},
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "An optional description for the evaluation.",
+			},
+			"success_threshold": schema.Float64Attribute{
+				Optional:            true,
+				Computed:            true, // API defaults to 1.0
+				MarkdownDescription: "The fraction of criteria (0.0 to 1.0) that must succeed for the evaluation to be considered successful. Defaults to 1.0.",
+				Validators:          []validator.Float64{float64validator.Between(0, 1)},
+				PlanModifiers: []planmodifier.Float64{
+					float64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The date and time the evaluation was created (RFC3339 format).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_by": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the user who created the evaluation.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"criterion": schema.ListNestedBlock{
+				MarkdownDescription: "A criterion the capability output is scored against. " +
+					"Criteria are matched to existing ones by content and type, so adding, removing or reordering criteria leaves the others untouched.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The unique identifier for the criterion.",
+						},
+						"type": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The criterion type, e.g. `correctness`, `answer_relevancy`, `bleu`, `rouge` or `custom_g_eval`.",
+							Validators:          []validator.String{stringvalidator.OneOf(enumStrings(api.AllowedCapabilityCriterionTypeEnumValues)...)},
+						},
+						"configuration": schema.StringAttribute{
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString("{}"),
+							MarkdownDescription: "The criterion configuration as a JSON object string, e.g. `jsonencode({ threshold = 0.5 })`. The supported fields depend on the criterion type.",
+						},
+						"model_deployment_id": schema.StringAttribute{
+							Optional:            true,
+							Computed:            true,
+							MarkdownDescription: "The UUID of the model deployment used to score LLM-based criteria. If not set, the API default is used.",
+							Validators:          []validator.String{uuidValidator()},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *CapabilityEvaluationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*coraxclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *coraxclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+// ModifyPlan matches the planned criteria to the existing ones, and validates each criterion against the
+// criterion types published by the API: the type must be offered by the server and valid for the
+// capability type, and the configuration must match the type's configuration fields.
+func (r *CapabilityEvaluationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan CapabilityEvaluationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.Criteria.IsNull() || plan.Criteria.IsUnknown() {
+		return
+	}
+
+	var criteria []CapabilityEvaluationCriterionModel
+	resp.Diagnostics.Append(plan.Criteria.ElementsAs(ctx, &criteria, false)...)
+	if resp.Diagnostics.HasError() || len(criteria) == 0 {
+		return
+	}
+
+	// Show which existing criteria are kept or updated in place, and which are created.
+	var configured, current []CapabilityEvaluationCriterionModel
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("criterion"), &configured)...)
+	if !req.State.Raw.IsNull() {
+		var state CapabilityEvaluationResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if !state.Criteria.IsNull() && !state.Criteria.IsUnknown() {
+			resp.Diagnostics.Append(state.Criteria.ElementsAs(ctx, &current, false)...)
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	criteria = planCapabilityEvaluationCriteria(criteria, configured, current)
+	criteriaVal, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: capabilityEvaluationCriterionAttributeTypes()}, criteria)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("criterion"), criteriaVal)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	criterionTypes, err := r.client.ListEvaluationCriterionTypes(ctx)
+	if err != nil {
+		resp.Diagnostics.AddWarning("Unable to Validate Criteria",
+			fmt.Sprintf("Unable to list evaluation criterion types, criterion configuration was not validated: %s", err))
+		return
+	}
+	byType := make(map[string]api.CapabilityEvaluationCriterionTypeRepresentation, len(criterionTypes))
+	for _, ct := range criterionTypes {
+		byType[string(ct.Type)] = ct
+	}
+
+	capabilityType := ""
+	if !plan.CapabilityID.IsNull() && !plan.CapabilityID.IsUnknown() {
+		capability, err := r.client.GetCapability(ctx, plan.CapabilityID.ValueString())
+		if err != nil {
+			tflog.Debug(ctx, fmt.Sprintf("Unable to read capability %s for criterion validation: %s", plan.CapabilityID.ValueString(), err))
+		} else {
+			capabilityType = capability.Type
+		}
+	}
+
+	for i, criterion := range criteria {
+		criterionPath := path.Root("criterion").AtListIndex(i)
+		if criterion.Type.IsNull() || criterion.Type.IsUnknown() {
+			continue
+		}
+
+		criterionType, ok := byType[criterion.Type.ValueString()]
+		if !ok {
+			resp.Diagnostics.AddAttributeError(criterionPath.AtName("type"), "Unsupported Criterion Type",
+				fmt.Sprintf("The criterion type %q is not offered by the Corax API.", criterion.Type.ValueString()))
+			continue
+		}
+
+		if capabilityType != "" && len(criterionType.ValidCapabilityTypes) > 0 {
+			valid := false
+			for _, t := range criterionType.ValidCapabilityTypes {
+				if string(t) == capabilityType {
+					valid = true
+					break
+				}
+			}
+			if !valid {
+				resp.Diagnostics.AddAttributeError(criterionPath.AtName("type"), "Criterion Type Not Valid for Capability",
+					fmt.Sprintf("The criterion type %q can't be used to evaluate %s capabilities. Valid capability types: %v.",
+						criterion.Type.ValueString(), capabilityType, criterionType.ValidCapabilityTypes))
+			}
+		}
+
+		if criterion.Configuration.IsNull() || criterion.Configuration.IsUnknown() {
+			continue
+		}
+		var configuration map[string]interface{}
+		if err := json.Unmarshal([]byte(criterion.Configuration.ValueString()), &configuration); err != nil {
+			resp.Diagnostics.AddAttributeError(criterionPath.AtName("configuration"), "Invalid JSON Object",
+				fmt.Sprintf("Failed to parse configuration as a JSON object: %s", err))
+			continue
+		}
+		validateConfigurationFields(criterionType.ConfigurationFields, configuration, criterionPath.AtName("configuration"), &resp.Diagnostics)
+	}
+}
+
+// reconcileCriteria applies the planned criteria to the evaluation. Planned criteria are matched to the
+// existing ones with matchCapabilityEvaluationCriteria: matched criteria are updated in place when they
+// changed, the others are created, and existing criteria without a match are deleted. It returns the
+// resulting criteria; when an error occurs, the existing criteria that weren't processed are kept.
+func (r *CapabilityEvaluationResource) reconcileCriteria(ctx context.Context, evaluationID string, planned, current []CapabilityEvaluationCriterionModel, diags *diag.Diagnostics) []CapabilityEvaluationCriterionModel {
+	result := make([]CapabilityEvaluationCriterionModel, 0, len(planned))
+	matches := matchCapabilityEvaluationCriteria(planned, current)
+	handled := make([]bool, len(current))
+
+	// withUnhandled keeps the existing criteria that weren't processed yet, so the next apply retries them.
+	withUnhandled := func(result []CapabilityEvaluationCriterionModel) []CapabilityEvaluationCriterionModel {
+		for j, existing := range current {
+			if !handled[j] {
+				result = append(result, existing)
+			}
+		}
+		return result
+	}
+
+	for i, criterion := range planned {
+		criterionPath := path.Root("criterion").AtListIndex(i)
+
+		if j := matches[i]; j >= 0 {
+			existing := current[j]
+			if !capabilityEvaluationCriterionChanged(criterion, existing) {
+				handled[j] = true
+				result = append(result, existing)
+				continue
+			}
+
+			criterionID := existing.ID.ValueString()
+			tflog.Debug(ctx, fmt.Sprintf("Updating criterion %s of Capability Evaluation %s", criterionID, evaluationID))
+			payload := capabilityEvaluationCriterionToAPI(criterion, criterionPath, diags)
+			if diags.HasError() {
+				return withUnhandled(result)
+			}
+			updated, err := r.client.UpdateEvaluationCriterion(ctx, evaluationID, criterionID, payload)
+			if err != nil {
+				diags.AddAttributeError(criterionPath, "Client Error",
+					fmt.Sprintf("Unable to update criterion %s of capability evaluation %s, got error: %s", criterionID, evaluationID, err))
+				return withUnhandled(result)
+			}
+			handled[j] = true
+			result = append(result, mapCapabilityEvaluationCriterionToModel(updated, criterion, diags))
+			continue
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("Creating %s criterion for Capability Evaluation %s", criterion.Type.ValueString(), evaluationID))
+		payload := capabilityEvaluationCriterionToAPI(criterion, criterionPath, diags)
+		if diags.HasError() {
+			return withUnhandled(result)
+		}
+		created, err := r.client.CreateEvaluationCriterion(ctx, evaluationID, payload)
+		if err != nil {
+			diags.AddAttributeError(criterionPath, "Client Error",
+				fmt.Sprintf("Unable to create criterion for capability evaluation %s, got error: %s", evaluationID, err))
+			return withUnhandled(result)
+		}
+		result = append(result, mapCapabilityEvaluationCriterionToModel(created, criterion, diags))
+	}
+
+	for j, existing := range current {
+		if handled[j] {
+			continue
+		}
+		criterionID := existing.ID.ValueString()
+		tflog.Debug(ctx, fmt.Sprintf("Deleting criterion %s of Capability Evaluation %s", criterionID, evaluationID))
+		err := r.client.DeleteEvaluationCriterion(ctx, evaluationID, criterionID)
+		if err != nil && !errors.Is(err, coraxclient.ErrNotFound) {
+			diags.AddError("Client Error",
+				fmt.Sprintf("Unable to delete criterion %s of capability evaluation %s, got error: %s", criterionID, evaluationID, err))
+			return withUnhandled(result)
+		}
+		handled[j] = true
+	}
+
+	return result
+}
+
+func setCapabilityEvaluationCriteria(ctx context.Context, model *CapabilityEvaluationResourceModel, criteria []CapabilityEvaluationCriterionModel, diags *diag.Diagnostics) {
+	if len(criteria) == 0 {
+		model.Criteria = types.ListValueMust(types.ObjectType{AttrTypes: capabilityEvaluationCriterionAttributeTypes()}, []attr.Value{})
+		return
+	}
+	listVal, listDiags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: capabilityEvaluationCriterionAttributeTypes()}, criteria)
+	diags.Append(listDiags...)
+	model.Criteria = listVal
+}
+
+func (r *CapabilityEvaluationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CapabilityEvaluationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var planned []CapabilityEvaluationCriterionModel
+	if !data.Criteria.IsNull() && !data.Criteria.IsUnknown() {
+		resp.Diagnostics.Append(data.Criteria.ElementsAs(ctx, &planned, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating Capability Evaluation with name: %s", data.Name.ValueString()))
+
+	createPayload := api.NewCapabilityEvaluationCreate(data.Name.ValueString(), data.CapabilityID.ValueString(), data.EvaluationDatasetID.ValueString(), capabilityEvaluationConfigurationToAPI(data))
+	if !data.ProjectID.IsNull() && !data.ProjectID.IsUnknown() {
+		createPayload.SetProjectId(data.ProjectID.ValueString())
+	}
+	if !data.IsPublic.IsNull() && !data.IsPublic.IsUnknown() {
+		createPayload.SetIsPublic(data.IsPublic.ValueBool())
+	}
+	if !data.CapabilityVersion.IsNull() && !data.CapabilityVersion.IsUnknown() {
+		createPayload.SetCapabilityVersion(int32(data.CapabilityVersion.ValueInt64()))
+	}
+
+	createdEvaluation, err := r.client.CreateCapabilityEvaluation(ctx, *createPayload)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create capability evaluation, got error: %s", err))
+		return
+	}
+
+	mapCapabilityEvaluationToModel(createdEvaluation, &data)
+	tflog.Info(ctx, fmt.Sprintf("Capability Evaluation created successfully with ID: %s", createdEvaluation.Id))
+
+	// Save the criteria that were created even if a later one fails, so they are tracked.
+	criteria := r.reconcileCriteria(ctx, createdEvaluation.Id, planned, nil, &resp.Diagnostics)
+	setCapabilityEvaluationCriteria(ctx, &data, criteria, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CapabilityEvaluationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CapabilityEvaluationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	evaluationID := data.ID.ValueString()
+	tflog.Debug(ctx, fmt.Sprintf("Reading Capability Evaluation with ID: %s", evaluationID))
+
+	evaluation, err := r.client.GetCapabilityEvaluation(ctx, evaluationID)
+	if err != nil {
+		if errors.Is(err, coraxclient.ErrNotFound) {
+			tflog.Warn(ctx, fmt.Sprintf("Capability Evaluation with ID %s not found, removing from state", evaluationID))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read capability evaluation %s, got error: %s", evaluationID, err))
+		return
+	}
+
+	apiCriteria, err := r.client.ListEvaluationCriteria(ctx, evaluationID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read criteria of capability evaluation %s, got error: %s", evaluationID, err))
+		return
+	}
+
+	var current []CapabilityEvaluationCriterionModel
+	if !data.Criteria.IsNull() && !data.Criteria.IsUnknown() {
+		resp.Diagnostics.Append(data.Criteria.ElementsAs(ctx, &current, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Keep the order of the criteria in state. Criteria deleted outside of Terraform are dropped,
+	// criteria added outside of Terraform (or on import) are appended in API order.
+	byID := make(map[string]*api.CapabilityEvaluationCriterionRepresentation, len(apiCriteria))
+	for i := range apiCriteria {
+		byID[apiCriteria[i].GetId()] = &apiCriteria[i]
+	}
+	criteria := make([]CapabilityEvaluationCriterionModel, 0, len(apiCriteria))
+	seen := make(map[string]bool, len(apiCriteria))
+	for _, existing := range current {
+		apiCriterion, ok := byID[existing.ID.ValueString()]
+		if !ok {
+			tflog.Warn(ctx, fmt.Sprintf("Criterion %s of Capability Evaluation %s not found", existing.ID.ValueString(), evaluationID))
+			continue
+		}
+		criteria = append(criteria, mapCapabilityEvaluationCriterionToModel(apiCriterion, existing, &resp.Diagnostics))
+		seen[existing.ID.ValueString()] = true
+	}
+	for i := range apiCriteria {
+		if !seen[apiCriteria[i].GetId()] {
+			criteria = append(criteria, mapCapabilityEvaluationCriterionToModel(&apiCriteria[i], CapabilityEvaluationCriterionModel{Configuration: types.StringNull()}, &resp.Diagnostics))
+		}
+	}
+
+	mapCapabilityEvaluationToModel(evaluation, &data)
+	setCapabilityEvaluationCriteria(ctx, &data, criteria, &resp.Diagnostics)
+	tflog.Debug(ctx, fmt.Sprintf("Successfully read Capability Evaluation with ID: %s", evaluationID))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CapabilityEvaluationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan CapabilityEvaluationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state CapabilityEvaluationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var planned, current []CapabilityEvaluationCriterionModel
+	if !plan.Criteria.IsNull() && !plan.Criteria.IsUnknown() {
+		resp.Diagnostics.Append(plan.Criteria.ElementsAs(ctx, &planned, false)...)
+	}
+	if !state.Criteria.IsNull() && !state.Criteria.IsUnknown() {
+		resp.Diagnostics.Append(state.Criteria.ElementsAs(ctx, &current, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	evaluationID := state.ID.ValueString() // ID comes from state, not plan
+	tflog.Debug(ctx, fmt.Sprintf("Updating Capability Evaluation with ID: %s", evaluationID))
+
+	updatePayload := api.NewCapabilityEvaluationUpdate(plan.Name.ValueString(), plan.CapabilityID.ValueString(), plan.EvaluationDatasetID.ValueString(), capabilityEvaluationConfigurationToAPI(plan))
+	if !plan.ProjectID.IsNull() && !plan.ProjectID.IsUnknown() {
+		updatePayload.SetProjectId(plan.ProjectID.ValueString())
+	} else {
+		updatePayload.SetProjectIdNil()
+	}
+	if !plan.IsPublic.IsNull() && !plan.IsPublic.IsUnknown() {
+		updatePayload.SetIsPublic(plan.IsPublic.ValueBool())
+	}
+	if !plan.CapabilityVersion.IsNull() && !plan.CapabilityVersion.IsUnknown() {
+		updatePayload.SetCapabilityVersion(int32(plan.CapabilityVersion.ValueInt64()))
+	}
+
+	updatedEvaluation, err := r.client.UpdateCapabilityEvaluation(ctx, evaluationID, *updatePayload)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update capability evaluation %s, got error: %s", evaluationID, err))
+		return
+	}
+
+	mapCapabilityEvaluationToModel(updatedEvaluation, &plan) // Update plan with response
+	criteria := r.reconcileCriteria(ctx, evaluationID, planned, current, &resp.Diagnostics)
+	setCapabilityEvaluationCriteria(ctx, &plan, criteria, &resp.Diagnostics)
+	tflog.Info(ctx, fmt.Sprintf("Capability Evaluation updated successfully with ID: %s", evaluationID))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *CapabilityEvaluationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CapabilityEvaluationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	evaluationID := data.ID.ValueString()
+	tflog.Debug(ctx, fmt.Sprintf("Deleting Capability Evaluation with ID: %s", evaluationID))
+
+	err := r.client.DeleteCapabilityEvaluation(ctx, evaluationID)
+	if err != nil {
+		if errors.Is(err, coraxclient.ErrNotFound) {
+			tflog.Warn(ctx, fmt.Sprintf("Capability Evaluation with ID %s already deleted, removing from state", evaluationID))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete capability evaluation %s, got error: %s", evaluationID, err))
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Capability Evaluation with ID %s deleted successfully", evaluationID))
+}
+
+func (r *CapabilityEvaluationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
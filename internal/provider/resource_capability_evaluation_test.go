@@ -0,0 +1,344 @@
+// Copyright (c) Trifork
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCapabilityEvaluationResource_basic(t *testing.T) {
+	if os.Getenv("CORAX_API_ENDPOINT") == "" || os.Getenv("CORAX_API_KEY") == "" {
+		t.Skip("Skipping acceptance test: CORAX_API_ENDPOINT or CORAX_API_KEY not set")
+	}
+
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	name := fmt.Sprintf("tf-acc-test-evaluation-%s", rName)
+	resourceName := "corax_capability_evaluation.test"
+	var correctnessID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccCapabilityEvaluationResourceConfig(name, 0.5, `
+  criterion {
+    type          = "correctness"
+    configuration = jsonencode({ threshold = 0.5 })
+  }
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "success_threshold", "0.5"),
+					resource.TestCheckResourceAttrPair(resourceName, "capability_id", "corax_chat_capability.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "evaluation_dataset_id", "corax_evaluation_dataset.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "criterion.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "criterion.0.type", "correctness"),
+					resource.TestCheckResourceAttrSet(resourceName, "criterion.0.id"),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"criterion.0.configuration"},
+			},
+			// Update and Read testing: change the threshold and add a criterion
+			{
+				Config: testAccCapabilityEvaluationResourceConfig(name, 0.75, `
+  criterion {
+    type          = "correctness"
+    configuration = jsonencode({ threshold = 0.5 })
+  }
+
+  criterion {
+    type = "answer_relevancy"
+  }
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "success_threshold", "0.75"),
+					resource.TestCheckResourceAttr(resourceName, "criterion.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "criterion.1.type", "answer_relevancy"),
+					resource.TestCheckResourceAttrWith(resourceName, "criterion.0.id", func(value string) error {
+						correctnessID = value
+						return nil
+					}),
+				),
+			},
+			// Insert a criterion at the front, the existing criteria keep their IDs
+			{
+				Config: testAccCapabilityEvaluationResourceConfig(name, 0.75, `
+  criterion {
+    type = "bleu"
+  }
+
+  criterion {
+    type          = "correctness"
+    configuration = jsonencode({ threshold = 0.5 })
+  }
+
+  criterion {
+    type = "answer_relevancy"
+  }
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "criterion.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "criterion.0.type", "bleu"),
+					resource.TestCheckResourceAttrWith(resourceName, "criterion.1.id", func(value string) error {
+						if value != correctnessID {
+							return fmt.Errorf("expected criterion %s to be kept, got %s", correctnessID, value)
+						}
+						return nil
+					}),
+				),
+			},
+			// Remove a criterion
+			{
+				Config: testAccCapabilityEvaluationResourceConfig(name, 0.75, `
+  criterion {
+    type = "answer_relevancy"
+  }
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "criterion.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "criterion.0.type", "answer_relevancy"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCapabilityEvaluationResourceConfig(name string, threshold float64, criteria string) string {
+	return fmt.Sprintf(`
+provider "corax" {}
+
+resource "corax_chat_capability" "test" {
+  name          = %[1]q
+  system_prompt = "You are a helpful assistant."
+}
+
+resource "corax_evaluation_dataset" "test" {
+  name = %[1]q
+
+  configuration = {
+    input_variables = ["question"]
+    output_type     = "text"
+  }
+}
+
+resource "corax_capability_evaluation" "test" {
+  name                  = %[1]q
+  capability_id         = corax_chat_capability.test.id
+  evaluation_dataset_id = corax_evaluation_dataset.test.id
+  success_threshold     = %[2]v
+%[3]s
+}
+`, name, threshold, criteria)
+}
+
+func TestJSONObjectSubsetAPIToString(t *testing.T) {
+	apiValue := map[string]interface{}{"threshold": float32(0.5), "include_reason": true}
+
+	var diags diag.Diagnostics
+	current := types.StringValue(`{"threshold": 0.5}`)
+	if got := jsonObjectSubsetAPIToString(current, apiValue, &diags); !got.Equal(current) {
+		t.Errorf("Expected current value to be kept, got %s", got)
+	}
+
+	changed := types.StringValue(`{"threshold": 0.7}`)
+	if got := jsonObjectSubsetAPIToString(changed, apiValue, &diags); got.ValueString() != `{"include_reason":true,"threshold":0.5}` {
+		t.Errorf("Expected API value, got %s", got)
+	}
+
+	if got := jsonObjectSubsetAPIToString(types.StringNull(), apiValue, &diags); got.ValueString() != `{"include_reason":true,"threshold":0.5}` {
+		t.Errorf("Expected API value for null current value, got %s", got)
+	}
+
+	if diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+}
+
+func TestCapabilityEvaluationCriterionChanged(t *testing.T) {
+	current := CapabilityEvaluationCriterionModel{
+		ID:                types.StringValue("c1"),
+		Type:              types.StringValue("correctness"),
+		Configuration:     types.StringValue(`{"threshold":0.5}`),
+		ModelDeploymentID: types.StringNull(),
+	}
+
+	tests := []struct {
+		name     string
+		planned  CapabilityEvaluationCriterionModel
+		expected bool
+	}{
+		{
+			name: "unchanged with reformatted configuration",
+			planned: CapabilityEvaluationCriterionModel{
+				ID:                types.StringValue("c1"),
+				Type:              types.StringValue("correctness"),
+				Configuration:     types.StringValue(`{ "threshold": 0.5 }`),
+				ModelDeploymentID: types.StringUnknown(),
+			},
+			expected: false,
+		},
+		{
+			name: "type changed",
+			planned: CapabilityEvaluationCriterionModel{
+				Type:              types.StringValue("bleu"),
+				Configuration:     types.StringValue(`{"threshold":0.5}`),
+				ModelDeploymentID: types.StringUnknown(),
+			},
+			expected: true,
+		},
+		{
+			name: "configuration changed",
+			planned: CapabilityEvaluationCriterionModel{
+				Type:              types.StringValue("correctness"),
+				Configuration:     types.StringValue(`{"threshold":0.6}`),
+				ModelDeploymentID: types.StringUnknown(),
+			},
+			expected: true,
+		},
+		{
+			name: "model deployment set",
+			planned: CapabilityEvaluationCriterionModel{
+				Type:              types.StringValue("correctness"),
+				Configuration:     types.StringValue(`{"threshold":0.5}`),
+				ModelDeploymentID: types.StringValue("00000000-0000-0000-0000-000000000001"),
+			},
+			expected: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := capabilityEvaluationCriterionChanged(tt.planned, current); got != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestMatchCapabilityEvaluationCriteria(t *testing.T) {
+	criterion := func(id, criterionType, configuration string) CapabilityEvaluationCriterionModel {
+		model := CapabilityEvaluationCriterionModel{
+			ID:                types.StringUnknown(),
+			Type:              types.StringValue(criterionType),
+			Configuration:     types.StringValue(configuration),
+			ModelDeploymentID: types.StringUnknown(),
+		}
+		if id != "" {
+			model.ID = types.StringValue(id)
+		}
+		return model
+	}
+	current := []CapabilityEvaluationCriterionModel{
+		criterion("c1", "correctness", `{"threshold":0.5}`),
+		criterion("c2", "answer_relevancy", `{}`),
+	}
+
+	tests := []struct {
+		name     string
+		planned  []CapabilityEvaluationCriterionModel
+		expected []int
+	}{
+		{
+			name: "insert at the front",
+			planned: []CapabilityEvaluationCriterionModel{
+				criterion("", "bleu", `{}`),
+				criterion("", "correctness", `{"threshold":0.5}`),
+				criterion("", "answer_relevancy", `{}`),
+			},
+			expected: []int{-1, 0, 1},
+		},
+		{
+			name: "insert the same type at the front",
+			planned: []CapabilityEvaluationCriterionModel{
+				criterion("", "correctness", `{"threshold":0.9}`),
+				criterion("", "correctness", `{"threshold":0.5}`),
+				criterion("", "answer_relevancy", `{}`),
+			},
+			expected: []int{-1, 0, 1},
+		},
+		{
+			name: "remove the first criterion",
+			planned: []CapabilityEvaluationCriterionModel{
+				criterion("", "answer_relevancy", `{}`),
+			},
+			expected: []int{1},
+		},
+		{
+			name: "changed configuration is updated in place",
+			planned: []CapabilityEvaluationCriterionModel{
+				criterion("", "answer_relevancy", `{}`),
+				criterion("", "correctness", `{"threshold":0.7}`),
+			},
+			expected: []int{1, 0},
+		},
+		{
+			name: "known IDs win",
+			planned: []CapabilityEvaluationCriterionModel{
+				criterion("", "correctness", `{"threshold":0.5}`),
+				criterion("c1", "correctness", `{"threshold":0.7}`),
+			},
+			expected: []int{-1, 0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := matchCapabilityEvaluationCriteria(tt.planned, current); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestPlanCapabilityEvaluationCriteria(t *testing.T) {
+	deployment := types.StringValue("00000000-0000-0000-0000-000000000001")
+	current := []CapabilityEvaluationCriterionModel{
+		{ID: types.StringValue("c1"), Type: types.StringValue("correctness"), Configuration: types.StringValue(`{}`), ModelDeploymentID: deployment},
+		{ID: types.StringValue("c2"), Type: types.StringValue("bleu"), Configuration: types.StringValue(`{}`), ModelDeploymentID: types.StringNull()},
+	}
+	// Inserting a criterion at the front: the plan copied the values of the existing criteria by position.
+	planned := []CapabilityEvaluationCriterionModel{
+		{ID: types.StringValue("c1"), Type: types.StringValue("rouge"), Configuration: types.StringValue(`{}`), ModelDeploymentID: deployment},
+		{ID: types.StringValue("c2"), Type: types.StringValue("correctness"), Configuration: types.StringValue(`{}`), ModelDeploymentID: types.StringNull()},
+		{ID: types.StringUnknown(), Type: types.StringValue("bleu"), Configuration: types.StringValue(`{}`), ModelDeploymentID: types.StringUnknown()},
+	}
+	configured := []CapabilityEvaluationCriterionModel{
+		{ID: types.StringNull(), Type: types.StringValue("rouge"), Configuration: types.StringValue(`{}`), ModelDeploymentID: types.StringNull()},
+		{ID: types.StringNull(), Type: types.StringValue("correctness"), Configuration: types.StringValue(`{}`), ModelDeploymentID: types.StringNull()},
+		{ID: types.StringNull(), Type: types.StringValue("bleu"), Configuration: types.StringValue(`{}`), ModelDeploymentID: types.StringNull()},
+	}
+
+	expected := []CapabilityEvaluationCriterionModel{
+		{ID: types.StringUnknown(), Type: types.StringValue("rouge"), Configuration: types.StringValue(`{}`), ModelDeploymentID: types.StringUnknown()},
+		{ID: types.StringValue("c1"), Type: types.StringValue("correctness"), Configuration: types.StringValue(`{}`), ModelDeploymentID: deployment},
+		{ID: types.StringValue("c2"), Type: types.StringValue("bleu"), Configuration: types.StringValue(`{}`), ModelDeploymentID: types.StringNull()},
+	}
+	if got := planCapabilityEvaluationCriteria(planned, configured, current); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+
+	// Unknown values can't be matched until apply.
+	planned[1].Configuration = types.StringUnknown()
+	for i, criterion := range planCapabilityEvaluationCriteria(planned, configured, current) {
+		if !criterion.ID.IsUnknown() {
+			t.Errorf("Expected unknown ID for criterion %d, got %s", i, criterion.ID)
+		}
+	}
+}
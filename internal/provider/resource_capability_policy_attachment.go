@@ -0,0 +1,367 @@
+// Copyright (c) Trifork
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-corax/internal/coraxclient"
+	api "terraform-provider-corax/internal/generated"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CapabilityPolicyAttachmentResource{}
+var _ resource.ResourceWithImportState = &CapabilityPolicyAttachmentResource{}
+var _ resource.ResourceWithModifyPlan = &CapabilityPolicyAttachmentResource{}
+
+func NewCapabilityPolicyAttachmentResource() resource.Resource {
+	return &CapabilityPolicyAttachmentResource{}
+}
+
+// CapabilityPolicyAttachmentResource defines the resource implementation.
+type CapabilityPolicyAttachmentResource struct {
+	client *coraxclient.Client
+}
+
+// CapabilityPolicyAttachmentResourceModel describes the resource data model.
+// Based on components.schemas.ApplyControlsResponse and components.schemas.ApplicablePolicyResponse.
+type CapabilityPolicyAttachmentResourceModel struct {
+	ID              types.String `tfsdk:"id"` // capability_id/policy_id
+	CapabilityID    types.String `tfsdk:"capability_id"`
+	PolicyID        types.String `tfsdk:"policy_id"`
+	PolicyName      types.String `tfsdk:"policy_name"`
+	ControlsSummary types.List   `tfsdk:"controls_summary"` // List of strings
+	AppliedControls types.List   `tfsdk:"applied_controls"` // List of CapabilityPolicyAppliedControlModel
+	Message         types.String `tfsdk:"message"`
+}
+
+// CapabilityPolicyAppliedControlModel maps to components.schemas.AppliedControl.
+type CapabilityPolicyAppliedControlModel struct {
+	Control    types.String `tfsdk:"control"`
+	PolicyName types.String `tfsdk:"policy_name"`
+}
+
+func capabilityPolicyAppliedControlAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"control":     types.StringType,
+		"policy_name": types.StringType,
+	}
+}
+
+// capabilityPolicyAttachmentID builds the composite resource ID.
+func capabilityPolicyAttachmentID(capabilityID, policyID string) string {
+	return capabilityID + "/" + policyID
+}
+
+// findApplicablePolicy returns the policy with the given ID from the policies applicable to a capability.
+func findApplicablePolicy(policies []api.ApplicablePolicyResponse, policyID string) *api.ApplicablePolicyResponse {
+	for i := range policies {
+		if policies[i].Id == policyID {
+			return &policies[i]
+		}
+	}
+	return nil
+}
+
+// mapAppliedControlsToModel maps the controls reported by an apply to the Terraform model.
+func mapAppliedControlsToModel(ctx context.Context, result *api.ApplyControlsResponse, model *CapabilityPolicyAttachmentResourceModel, diags *diag.Diagnostics) {
+	controls := make([]CapabilityPolicyAppliedControlModel, 0, len(result.AppliedControls))
+	for _, control := range result.AppliedControls {
+		controls = append(controls, CapabilityPolicyAppliedControlModel{
+			Control:    types.StringValue(control.Control),
+			PolicyName: types.StringValue(control.PolicyName),
+		})
+	}
+	listVal, listDiags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: capabilityPolicyAppliedControlAttributeTypes()}, controls)
+	diags.Append(listDiags...)
+	model.AppliedControls = listVal
+	model.Message = types.StringValue(result.Message)
+}
+
+// mapApplicablePolicyToModel maps an api.ApplicablePolicyResponse to the Terraform model.
+func mapApplicablePolicyToModel(ctx context.Context, policy *api.ApplicablePolicyResponse, model *CapabilityPolicyAttachmentResourceModel, diags *diag.Diagnostics) {
+	model.PolicyName = types.StringValue(policy.Name)
+	summary := policy.ControlsSummary
+	if summary == nil {
+		summary = []string{}
+	}
+	listVal, listDiags := types.ListValueFrom(ctx, types.StringType, summary)
+	diags.Append(listDiags...)
+	model.ControlsSummary = listVal
+}
+
+func (r *CapabilityPolicyAttachmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_capability_policy_attachment"
+}
+
+func (r *CapabilityPolicyAttachmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Applies the controls of a Corax Compliance Policy to a capability. " +
+			"Destroying the resource removes the policy's controls from the capability again. " +
+			"If the policy is no longer applied to the capability, the attachment is recreated on the next apply.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the attachment, in the format `capability_id/policy_id`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"capability_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The UUID of the capability the policy is applied to. Changing this forces a new attachment.",
+				Validators:          []validator.String{uuidValidator()},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"policy_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The UUID of the compliance policy to apply. Changing this forces a new attachment.",
+				Validators:          []validator.String{uuidValidator()},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"policy_name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The name of the compliance policy.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"controls_summary": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: "A summary of the controls the policy defines, as reported by the API.",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"applied_controls": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The controls that were applied to the capability when the attachment was created. Not populated on import.",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"control": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the applied control.",
+						},
+						"policy_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the policy the control was applied from.",
+						},
+					},
+				},
+			},
+			"message": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The message returned by the API when the policy was applied. Not populated on import.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *CapabilityPolicyAttachmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*coraxclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *coraxclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+// ModifyPlan refuses the resource when the server has the compliance feature disabled.
+func (r *CapabilityPolicyAttachmentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	requireFeature(r.client, coraxclient.FeatureCompliance, "corax_capability_policy_attachment", &resp.Diagnostics)
+}
+
+// rollbackCreate removes the controls of a policy that Create just applied, so a failed Create doesn't
+// leave an attachment behind that isn't tracked in the Terraform state. A policy that was already
+// applied before Create is left in place, as this resource didn't apply it.
+func (r *CapabilityPolicyAttachmentResource) rollbackCreate(ctx context.Context, capabilityID string, policyID string, wasApplied bool, diags *diag.Diagnostics) {
+	if wasApplied {
+		tflog.Debug(ctx, fmt.Sprintf("Compliance Policy %s was already applied to Capability %s, not rolling back", policyID, capabilityID))
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Rolling back Compliance Policy %s on Capability %s", policyID, capabilityID))
+	_, err := r.client.RemoveCompliancePolicyFromCapability(ctx, capabilityID, policyID)
+	if err != nil && !errors.Is(err, coraxclient.ErrNotFound) {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to roll back compliance policy %s on capability %s, its controls remain applied, got error: %s", policyID, capabilityID, err))
+	}
+}
+
+func (r *CapabilityPolicyAttachmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan CapabilityPolicyAttachmentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	capabilityID := plan.CapabilityID.ValueString()
+	policyID := plan.PolicyID.ValueString()
+
+	// Record whether the policy is already applied, so a failed Create only rolls back what it changed.
+	policies, err := r.client.GetApplicableCompliancePolicies(ctx, capabilityID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read compliance policies of capability %s, got error: %s", capabilityID, err))
+		return
+	}
+	existing := findApplicablePolicy(policies, policyID)
+	wasApplied := existing != nil && existing.IsApplied
+
+	tflog.Debug(ctx, fmt.Sprintf("Applying Compliance Policy %s to Capability %s", policyID, capabilityID))
+
+	result, err := r.client.ApplyCompliancePolicyToCapability(ctx, capabilityID, policyID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to apply compliance policy %s to capability %s, got error: %s", policyID, capabilityID, err))
+		return
+	}
+
+	plan.ID = types.StringValue(capabilityPolicyAttachmentID(capabilityID, policyID))
+	mapAppliedControlsToModel(ctx, result, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		r.rollbackCreate(ctx, capabilityID, policyID, wasApplied, &resp.Diagnostics)
+		return
+	}
+
+	policies, err = r.client.GetApplicableCompliancePolicies(ctx, capabilityID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read compliance policies of capability %s after apply, got error: %s", capabilityID, err))
+		r.rollbackCreate(ctx, capabilityID, policyID, wasApplied, &resp.Diagnostics)
+		return
+	}
+	// Read drops attachments whose policy isn't applied, so require the same here to avoid recreating it forever.
+	policy := findApplicablePolicy(policies, policyID)
+	if policy == nil || !policy.IsApplied {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Compliance policy %s is not applied to capability %s after apply", policyID, capabilityID))
+		r.rollbackCreate(ctx, capabilityID, policyID, wasApplied, &resp.Diagnostics)
+		return
+	}
+	mapApplicablePolicyToModel(ctx, policy, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		r.rollbackCreate(ctx, capabilityID, policyID, wasApplied, &resp.Diagnostics)
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("Applied %d controls of Compliance Policy %s to Capability %s", len(result.AppliedControls), policyID, capabilityID))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *CapabilityPolicyAttachmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CapabilityPolicyAttachmentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	capabilityID := data.CapabilityID.ValueString()
+	policyID := data.PolicyID.ValueString()
+	tflog.Debug(ctx, fmt.Sprintf("Reading Compliance Policy %s attachment to Capability %s", policyID, capabilityID))
+
+	policies, err := r.client.GetApplicableCompliancePolicies(ctx, capabilityID)
+	if err != nil {
+		if errors.Is(err, coraxclient.ErrNotFound) {
+			tflog.Warn(ctx, fmt.Sprintf("Capability %s not found, removing policy attachment from state", capabilityID))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read compliance policies of capability %s, got error: %s", capabilityID, err))
+		return
+	}
+
+	policy := findApplicablePolicy(policies, policyID)
+	if policy == nil || !policy.IsApplied {
+		tflog.Warn(ctx, fmt.Sprintf("Compliance Policy %s is no longer applied to Capability %s, removing from state", policyID, capabilityID))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.ID = types.StringValue(capabilityPolicyAttachmentID(capabilityID, policyID))
+	mapApplicablePolicyToModel(ctx, policy, &data, &resp.Diagnostics)
+	if data.AppliedControls.IsNull() || data.AppliedControls.IsUnknown() {
+		data.AppliedControls = types.ListNull(types.ObjectType{AttrTypes: capabilityPolicyAppliedControlAttributeTypes()})
+	}
+	if data.Message.IsUnknown() {
+		data.Message = types.StringNull()
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is never called with changes to the inputs, as both force a new attachment.
+func (r *CapabilityPolicyAttachmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan CapabilityPolicyAttachmentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *CapabilityPolicyAttachmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CapabilityPolicyAttachmentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	capabilityID := data.CapabilityID.ValueString()
+	policyID := data.PolicyID.ValueString()
+	tflog.Debug(ctx, fmt.Sprintf("Removing Compliance Policy %s from Capability %s", policyID, capabilityID))
+
+	result, err := r.client.RemoveCompliancePolicyFromCapability(ctx, capabilityID, policyID)
+	if err != nil {
+		if errors.Is(err, coraxclient.ErrNotFound) {
+			tflog.Warn(ctx, fmt.Sprintf("Compliance Policy %s or Capability %s not found, assuming the attachment is already removed", policyID, capabilityID))
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove compliance policy %s from capability %s, got error: %s", policyID, capabilityID, err))
+		return
+	}
+	tflog.Trace(ctx, fmt.Sprintf("Removed %d controls of Compliance Policy %s from Capability %s", len(result.RemovedControls), policyID, capabilityID))
+}
+
+func (r *CapabilityPolicyAttachmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: capability_id/policy_id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("capability_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("policy_id"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
@@ -0,0 +1,181 @@
+// Copyright (c) Trifork
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-corax/internal/coraxclient"
+	api "terraform-provider-corax/internal/generated"
+)
+
+func TestAccCapabilityPolicyAttachmentResource_basic(t *testing.T) {
+	if os.Getenv("CORAX_API_ENDPOINT") == "" || os.Getenv("CORAX_API_KEY") == "" {
+		t.Skip("Skipping acceptance test: CORAX_API_ENDPOINT or CORAX_API_KEY not set")
+	}
+
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	name := fmt.Sprintf("tf-acc-test-policy-attachment-%s", rName)
+	resourceName := "corax_capability_policy_attachment.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccCapabilityPolicyAttachmentResourceConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "capability_id", "corax_chat_capability.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "policy_id", "corax_compliance_policy.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "policy_name", name),
+					resource.TestCheckResourceAttrSet(resourceName, "applied_controls.#"),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"applied_controls", "message"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCapabilityPolicyAttachmentResourceConfig(name string) string {
+	return fmt.Sprintf(`
+provider "corax" {}
+
+resource "corax_project" "test" {
+  name = %[1]q
+}
+
+resource "corax_chat_capability" "test" {
+  name          = %[1]q
+  project_id    = corax_project.test.id
+  system_prompt = "You are a helpful assistant."
+}
+
+resource "corax_compliance_policy" "test" {
+  name       = %[1]q
+  scope_type = "project"
+  scope_id   = corax_project.test.id
+
+  prompt_shield {}
+
+  pii_detection {
+    input_mode  = "redact"
+    output_mode = "redact"
+  }
+}
+
+resource "corax_capability_policy_attachment" "test" {
+  capability_id = corax_chat_capability.test.id
+  policy_id     = corax_compliance_policy.test.id
+}
+`, name)
+}
+
+func TestFindApplicablePolicy(t *testing.T) {
+	policies := []api.ApplicablePolicyResponse{
+		{Id: "policy-1", Name: "Baseline", IsApplied: true},
+		{Id: "policy-2", Name: "Strict"},
+	}
+
+	if policy := findApplicablePolicy(policies, "policy-2"); policy == nil || policy.Name != "Strict" {
+		t.Errorf("Expected policy-2, got %+v", policy)
+	}
+	if policy := findApplicablePolicy(policies, "policy-3"); policy != nil {
+		t.Errorf("Expected no policy, got %+v", policy)
+	}
+}
+
+func TestMapCapabilityPolicyAttachmentToModel(t *testing.T) {
+	ctx := context.Background()
+	var diags diag.Diagnostics
+	model := CapabilityPolicyAttachmentResourceModel{}
+
+	mapAppliedControlsToModel(ctx, &api.ApplyControlsResponse{
+		AppliedControls: []api.AppliedControl{
+			{Control: "prompt_shield", PolicyName: "Baseline"},
+			{Control: "pii_detection", PolicyName: "Baseline"},
+		},
+		Message: "Applied 2 controls",
+	}, &model, &diags)
+	mapApplicablePolicyToModel(ctx, &api.ApplicablePolicyResponse{Id: "policy-1", Name: "Baseline", IsApplied: true}, &model, &diags)
+	if diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+
+	var controls []CapabilityPolicyAppliedControlModel
+	diags.Append(model.AppliedControls.ElementsAs(ctx, &controls, false)...)
+	if len(controls) != 2 || controls[1].Control.ValueString() != "pii_detection" {
+		t.Errorf("Unexpected applied controls: %+v", controls)
+	}
+	if model.Message.ValueString() != "Applied 2 controls" || model.PolicyName.ValueString() != "Baseline" {
+		t.Errorf("Unexpected message or policy name: %s, %s", model.Message, model.PolicyName)
+	}
+	// A missing summary is an empty list, so refreshes do not flip between null and empty.
+	if model.ControlsSummary.IsNull() || len(model.ControlsSummary.Elements()) != 0 {
+		t.Errorf("Expected empty controls summary, got %s", model.ControlsSummary)
+	}
+}
+
+func TestCapabilityPolicyAttachmentRollbackCreate(t *testing.T) {
+	var removed []string
+	failing := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method != http.MethodDelete {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		removed = append(removed, r.URL.Path)
+		if failing {
+			w.WriteHeader(http.StatusInternalServerError)
+			_ = json.NewEncoder(w).Encode(map[string]string{"detail": "boom"})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"removed_controls": []interface{}{}, "message": "Removed"})
+	}))
+	defer server.Close()
+
+	client, err := coraxclient.NewClient(server.URL, "test-api-key")
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	r := &CapabilityPolicyAttachmentResource{client: client}
+
+	var diags diag.Diagnostics
+	r.rollbackCreate(context.Background(), "cap-1", "policy-1", false, &diags)
+	if diags.HasError() {
+		t.Errorf("Unexpected diagnostics: %v", diags)
+	}
+	if len(removed) != 1 || removed[0] != "/v1/compliance/capabilities/cap-1/policies/policy-1" {
+		t.Errorf("Expected the policy to be removed from the capability, got %v", removed)
+	}
+
+	// A policy that was applied before Create is left in place.
+	r.rollbackCreate(context.Background(), "cap-1", "policy-1", true, &diags)
+	if diags.HasError() || len(removed) != 1 {
+		t.Errorf("Expected an already applied policy not to be removed, got %v (diagnostics: %v)", removed, diags)
+	}
+
+	failing = true
+	r.rollbackCreate(context.Background(), "cap-1", "policy-1", false, &diags)
+	if diags.ErrorsCount() != 1 {
+		t.Errorf("Expected a single error when the rollback fails, got %v", diags)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -40,21 +41,22 @@ type ChatCapabilityResource struct {
 
 // ChatCapabilityResourceModel describes the resource data model.
 type ChatCapabilityResourceModel struct {
-	ID           types.String `tfsdk:"id"`
-	Name         types.String `tfsdk:"name"`
-	SemanticID   types.String `tfsdk:"semantic_id"` // Optional
-	IsPublic     types.Bool   `tfsdk:"is_public"`
-	ModelID      types.String `tfsdk:"model_id"`   // Nullable
-	Config       types.Object `tfsdk:"config"`     // Nullable
-	ProjectID    types.String `tfsdk:"project_id"` // Nullable
-	SystemPrompt types.String `tfsdk:"system_prompt"`
-	// CollectionIDs types.List   `tfsdk:"collection_ids"` // Omitted for now as per decision to skip collection-related features
-	Owner     types.String `tfsdk:"owner"`      // Computed
-	Type      types.String `tfsdk:"type"`       // Computed, should always be "chat"
-	CreatedAt types.String `tfsdk:"created_at"` // Computed
-	UpdatedAt types.String `tfsdk:"updated_at"` // Computed
-	CreatedBy types.String `tfsdk:"created_by"` // Computed
-	UpdatedBy types.String `tfsdk:"updated_by"` // Computed
+	ID            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	SemanticID    types.String `tfsdk:"semantic_id"` // Optional
+	IsPublic      types.Bool   `tfsdk:"is_public"`
+	ModelID       types.String `tfsdk:"model_id"`      // Nullable
+	ModelPoolID   types.String `tfsdk:"model_pool_id"` // Nullable
+	Config        types.Object `tfsdk:"config"`        // Nullable
+	ProjectID     types.String `tfsdk:"project_id"`    // Nullable
+	SystemPrompt  types.String `tfsdk:"system_prompt"`
+	CollectionIDs types.Set    `tfsdk:"collection_ids"` // Nullable set of collection UUIDs
+	Owner         types.String `tfsdk:"owner"`          // Computed
+	Type          types.String `tfsdk:"type"`           // Computed, should always be "chat"
+	CreatedAt     types.String `tfsdk:"created_at"`     // Computed
+	UpdatedAt     types.String `tfsdk:"updated_at"`     // Computed
+	CreatedBy     types.String `tfsdk:"created_by"`     // Computed
+	UpdatedBy     types.String `tfsdk:"updated_by"`     // Computed
 }
 
 func (r *ChatCapabilityResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -89,9 +91,17 @@ func (r *ChatCapabilityResource) Schema(ctx context.Context, req resource.Schema
 			},
 			"model_id": schema.StringAttribute{
 				Optional:            true,
-				MarkdownDescription: "The UUID of the model deployment to use for this capability. If not provided, a default model for 'chat' type may be used by the API.",
+				MarkdownDescription: "The UUID of the model deployment to use for this capability. Mutually exclusive with `model_pool_id`. If neither is provided, the default model for the 'chat' capability type is used by the API.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("model_pool_id")),
+				},
 				// TODO: Add validator for UUID format
 			},
+			"model_pool_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The UUID of the model pool to route requests for this capability through. Mutually exclusive with `model_id`.",
+				Validators:          []validator.String{uuidValidator()},
+			},
 			"project_id": schema.StringAttribute{
 				Optional:            true,
 				MarkdownDescription: "The UUID of the project this capability belongs to. If not provided, it might be associated with a default or no project.",
@@ -101,11 +111,14 @@ func (r *ChatCapabilityResource) Schema(ctx context.Context, req resource.Schema
 				Required:            true,
 				MarkdownDescription: "The system prompt that guides the behavior of the chat model.",
 			},
-			// "collection_ids": schema.ListAttribute{ // Omitted for now
-			// 	ElementType:         types.StringType,
-			// 	Optional:            true,
-			// 	MarkdownDescription: "A list of collection UUIDs to be used for retrieval augmentation (RAG) by this chat capability.",
-			// },
+			"collection_ids": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "A set of knowledge collection UUIDs to be used for retrieval augmentation (RAG) by this chat capability.",
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(uuidValidator()),
+				},
+			},
 			"config": schema.SingleNestedAttribute{
 				Optional:            true,
 				Computed:            true,
@@ -161,11 +174,9 @@ func mapChatCapabilityRepresentationToModel(apiCap *api.CapabilityRepresentation
 	model.IsPublic = types.BoolValue(apiCap.GetIsPublic())
 	model.Type = types.StringValue(apiCap.Type)
 
-	if modelId, ok := apiCap.GetModelIdOk(); ok && modelId != nil {
-		model.ModelID = types.StringValue(*modelId)
-	} else {
-		model.ModelID = types.StringNull()
-	}
+	modelId, _ := apiCap.GetModelIdOk()
+	modelPoolId, _ := apiCap.GetModelPoolIdOk()
+	model.ModelID, model.ModelPoolID = capabilityModelRoutingAPIToModel(modelId, modelPoolId)
 	if projectId, ok := apiCap.GetProjectIdOk(); ok && projectId != nil {
 		model.ProjectID = types.StringValue(*projectId)
 	} else {
@@ -180,6 +191,17 @@ func mapChatCapabilityRepresentationToModel(apiCap *api.CapabilityRepresentation
 		tflog.Warn(ctx, fmt.Sprintf("System prompt not found in API response configuration for capability %s", apiCap.Id))
 	}
 
+	// CollectionIDs are also returned in apiCap.Configuration map
+	var collectionIDs []string
+	if rawIDs, ok := apiCap.Configuration["collection_ids"].([]interface{}); ok {
+		for _, rawID := range rawIDs {
+			if id, ok := rawID.(string); ok {
+				collectionIDs = append(collectionIDs, id)
+			}
+		}
+	}
+	model.CollectionIDs = collectionIDsAPIToModel(ctx, collectionIDs, model.CollectionIDs, diags)
+
 	// Extract config from NullableCapabilityConfig
 	var cfgPtr *api.CapabilityConfig
 	if configVal, ok := apiCap.GetConfigOk(); ok {
@@ -206,11 +228,9 @@ func mapChatCapabilityCreateResponseToModel(apiCap *api.ChatCapability, model *C
 		model.Type = types.StringValue("chat")
 	}
 
-	if modelId, ok := apiCap.GetModelIdOk(); ok && modelId != nil {
-		model.ModelID = types.StringValue(*modelId)
-	} else {
-		model.ModelID = types.StringNull()
-	}
+	modelId, _ := apiCap.GetModelIdOk()
+	modelPoolId, _ := apiCap.GetModelPoolIdOk()
+	model.ModelID, model.ModelPoolID = capabilityModelRoutingAPIToModel(modelId, modelPoolId)
 	if projectId, ok := apiCap.GetProjectIdOk(); ok && projectId != nil {
 		model.ProjectID = types.StringValue(*projectId)
 	} else {
@@ -223,6 +243,7 @@ func mapChatCapabilityCreateResponseToModel(apiCap *api.ChatCapability, model *C
 	}
 
 	model.SystemPrompt = types.StringValue(apiCap.SystemPrompt)
+	model.CollectionIDs = collectionIDsAPIToModel(ctx, apiCap.CollectionIds, model.CollectionIDs, diags)
 
 	// Extract config from NullableCapabilityConfig
 	var cfgPtr *api.CapabilityConfig
@@ -238,6 +259,21 @@ func mapChatCapabilityCreateResponseToModel(apiCap *api.ChatCapability, model *C
 	model.UpdatedBy = types.StringValue(apiCap.UpdatedBy)
 }
 
+// collectionIDsAPIToModel converts collection IDs returned by the API to a set.
+// An empty API list maps to null unless the current value is an explicitly empty set,
+// so that neither `collection_ids = []` nor an omitted attribute produce a diff.
+func collectionIDsAPIToModel(ctx context.Context, collectionIDs []string, current types.Set, diags *diag.Diagnostics) types.Set {
+	if len(collectionIDs) == 0 {
+		if !current.IsNull() && !current.IsUnknown() && len(current.Elements()) == 0 {
+			return current
+		}
+		return types.SetNull(types.StringType)
+	}
+	setValue, setDiags := types.SetValueFrom(ctx, types.StringType, collectionIDs)
+	diags.Append(setDiags...)
+	return setValue
+}
+
 func (r *ChatCapabilityResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan ChatCapabilityResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -258,9 +294,20 @@ func (r *ChatCapabilityResource) Create(ctx context.Context, req resource.Create
 	if !plan.ModelID.IsNull() && !plan.ModelID.IsUnknown() {
 		apiPayload.SetModelId(plan.ModelID.ValueString())
 	}
+	if !plan.ModelPoolID.IsNull() && !plan.ModelPoolID.IsUnknown() {
+		apiPayload.SetModelPoolId(plan.ModelPoolID.ValueString())
+	}
 	if !plan.ProjectID.IsNull() && !plan.ProjectID.IsUnknown() {
 		apiPayload.SetProjectId(plan.ProjectID.ValueString())
 	}
+	if !plan.CollectionIDs.IsNull() && !plan.CollectionIDs.IsUnknown() {
+		var collectionIDs []string
+		resp.Diagnostics.Append(plan.CollectionIDs.ElementsAs(ctx, &collectionIDs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		apiPayload.SetCollectionIds(collectionIDs)
+	}
 
 	apiConfig := capabilityConfigModelToAPI(ctx, plan.Config, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
@@ -359,9 +406,18 @@ func (r *ChatCapabilityResource) Update(ctx context.Context, req resource.Update
 		updatePayload.SetSemanticId(plan.SemanticID.ValueString())
 	}
 
-	// ModelID
+	// ModelID and ModelPoolID are mutually exclusive; explicitly clear whichever
+	// one is no longer configured so the server drops the previous routing.
 	if !plan.ModelID.IsNull() && !plan.ModelID.IsUnknown() {
 		updatePayload.SetModelId(plan.ModelID.ValueString())
+	} else if plan.ModelID.IsNull() {
+		updatePayload.SetModelIdNil()
+	}
+
+	if !plan.ModelPoolID.IsNull() && !plan.ModelPoolID.IsUnknown() {
+		updatePayload.SetModelPoolId(plan.ModelPoolID.ValueString())
+	} else if plan.ModelPoolID.IsNull() {
+		updatePayload.SetModelPoolIdNil()
 	}
 
 	// ProjectID
@@ -372,6 +428,19 @@ func (r *ChatCapabilityResource) Update(ctx context.Context, req resource.Update
 	// SystemPrompt
 	updatePayload.SetSystemPrompt(plan.SystemPrompt.ValueString())
 
+	// CollectionIDs
+	if !plan.CollectionIDs.IsNull() && !plan.CollectionIDs.IsUnknown() {
+		var collectionIDs []string
+		resp.Diagnostics.Append(plan.CollectionIDs.ElementsAs(ctx, &collectionIDs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		updatePayload.SetCollectionIds(collectionIDs)
+	} else if plan.CollectionIDs.IsNull() && !state.CollectionIDs.IsNull() {
+		// Detach the previously attached collections when the attribute is removed.
+		updatePayload.SetCollectionIds([]string{})
+	}
+
 	// Config
 	apiConfig := capabilityConfigModelToAPI(ctx, plan.Config, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
@@ -3,10 +3,14 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -100,6 +104,154 @@ func TestAccChatCapabilityResource_withConfig(t *testing.T) {
 	})
 }
 
+func TestAccChatCapabilityResource_collectionIDs(t *testing.T) {
+	if os.Getenv("CORAX_API_ENDPOINT") == "" || os.Getenv("CORAX_API_KEY") == "" {
+		t.Skip("Skipping acceptance test: CORAX_API_ENDPOINT or CORAX_API_KEY not set")
+	}
+
+	resourceName := "corax_chat_capability.test_with_collections"
+	capabilityName := "tf-acc-test-chat-cap-collections"
+	systemPrompt := "You answer using the attached collections."
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChatCapabilityResourceWithCollections(capabilityName, systemPrompt, `[corax_knowledge_collection.b.id, corax_knowledge_collection.a.id]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "collection_ids.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "collection_ids.*", "corax_knowledge_collection.a", "id"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "collection_ids.*", "corax_knowledge_collection.b", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Remove one collection
+			{
+				Config: testAccChatCapabilityResourceWithCollections(capabilityName, systemPrompt, `[corax_knowledge_collection.a.id]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "collection_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "collection_ids.*", "corax_knowledge_collection.a", "id"),
+				),
+			},
+			// Remove the attribute to detach all collections
+			{
+				Config: testAccChatCapabilityResourceWithCollections(capabilityName, systemPrompt, `null`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr(resourceName, "collection_ids"),
+				),
+			},
+		},
+	})
+}
+
+const testAccChatCapabilityModelPoolIDEnvVar = "CORAX_TEST_MODEL_POOL_ID"
+
+func TestAccChatCapabilityResource_modelPool(t *testing.T) {
+	if os.Getenv("CORAX_API_ENDPOINT") == "" || os.Getenv("CORAX_API_KEY") == "" {
+		t.Skip("Skipping acceptance test: CORAX_API_ENDPOINT or CORAX_API_KEY not set")
+	}
+	testModelPoolID := os.Getenv(testAccChatCapabilityModelPoolIDEnvVar)
+	if testModelPoolID == "" {
+		t.Skipf("Skipping acceptance test: %s must be set with a valid Model Pool UUID", testAccChatCapabilityModelPoolIDEnvVar)
+	}
+	testModelID := os.Getenv(testAccCapabilityTypeDefaultModelDeploymentIDEnvVar)
+	if testModelID == "" {
+		t.Skipf("Skipping acceptance test: %s must be set with a valid Model Deployment UUID", testAccCapabilityTypeDefaultModelDeploymentIDEnvVar)
+	}
+
+	resourceName := "corax_chat_capability.test_with_pool"
+	capabilityName := "tf-acc-test-chat-cap-pool"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChatCapabilityResourceWithRouting(capabilityName, "model_pool_id", testModelPoolID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "model_pool_id", testModelPoolID),
+					resource.TestCheckNoResourceAttr(resourceName, "model_id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Switch from pool routing to a single model
+			{
+				Config: testAccChatCapabilityResourceWithRouting(capabilityName, "model_id", testModelID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "model_id", testModelID),
+					resource.TestCheckNoResourceAttr(resourceName, "model_pool_id"),
+				),
+			},
+			// Switch back from a single model to pool routing
+			{
+				Config: testAccChatCapabilityResourceWithRouting(capabilityName, "model_pool_id", testModelPoolID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "model_pool_id", testModelPoolID),
+					resource.TestCheckNoResourceAttr(resourceName, "model_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestCollectionIDsAPIToModel(t *testing.T) {
+	ctx := context.Background()
+	emptySet := types.SetValueMust(types.StringType, []attr.Value{})
+
+	tests := []struct {
+		name     string
+		apiIDs   []string
+		current  types.Set
+		expected types.Set
+	}{
+		{
+			name:     "nil from API with null state",
+			apiIDs:   nil,
+			current:  types.SetNull(types.StringType),
+			expected: types.SetNull(types.StringType),
+		},
+		{
+			name:     "empty from API keeps explicit empty set",
+			apiIDs:   []string{},
+			current:  emptySet,
+			expected: emptySet,
+		},
+		{
+			name:    "ordering from API does not matter",
+			apiIDs:  []string{"b", "a"},
+			current: types.SetNull(types.StringType),
+			expected: types.SetValueMust(types.StringType, []attr.Value{
+				types.StringValue("a"),
+				types.StringValue("b"),
+			}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			result := collectionIDsAPIToModel(ctx, tt.apiIDs, tt.current, &diags)
+			if diags.HasError() {
+				t.Fatalf("Unexpected diagnostics: %v", diags)
+			}
+			if !result.Equal(tt.expected) {
+				t.Errorf("Expected %s, got %s", tt.expected, result)
+			}
+		})
+	}
+}
+
 func testAccChatCapabilityResourceBasicConfig(name, systemPrompt string) string {
 	return fmt.Sprintf(`
 provider "corax" {
@@ -139,6 +291,26 @@ resource "corax_chat_capability" "test_with_config" {
 `, name, systemPrompt)
 }
 
+func testAccChatCapabilityResourceWithCollections(name, systemPrompt, collectionIDs string) string {
+	return fmt.Sprintf(`
+provider "corax" {}
+
+resource "corax_knowledge_collection" "a" {
+  name = "%[1]s-a"
+}
+
+resource "corax_knowledge_collection" "b" {
+  name = "%[1]s-b"
+}
+
+resource "corax_chat_capability" "test_with_collections" {
+  name           = "%[1]s"
+  system_prompt  = "%[2]s"
+  collection_ids = %[3]s
+}
+`, name, systemPrompt, collectionIDs)
+}
+
 func testAccChatCapabilityResourceWithUpdatedConfig(name, systemPrompt string) string {
 	return fmt.Sprintf(`
 provider "corax" {}
@@ -170,3 +342,15 @@ resource "corax_chat_capability" "test_with_config" {
 // 		t.Fatal("CORAX_API_KEY must be set for acceptance tests")
 // 	}
 // }
+
+func testAccChatCapabilityResourceWithRouting(name, routingAttr, routingID string) string {
+	return fmt.Sprintf(`
+provider "corax" {}
+
+resource "corax_chat_capability" "test_with_pool" {
+  name          = "%s"
+  system_prompt = "You are routed through a model pool."
+  %s = "%s"
+}
+`, name, routingAttr, routingID)
+}
@@ -47,6 +47,7 @@ type CompletionCapabilityResourceModel struct {
 	SemanticID       types.String `tfsdk:"semantic_id"` // Optional
 	IsPublic         types.Bool   `tfsdk:"is_public"`
 	ModelID          types.String `tfsdk:"model_id"`      // Nullable
+	ModelPoolID      types.String `tfsdk:"model_pool_id"` // Nullable
 	Config           types.Object `tfsdk:"config"`        // Nullable, uses CapabilityConfigModel from chat_capability.go
 	ProjectID        types.String `tfsdk:"project_id"`    // Nullable
 	SystemPrompt     types.String `tfsdk:"system_prompt"` // Shared with Chat, but also in Completion
@@ -97,7 +98,15 @@ func (r *CompletionCapabilityResource) Schema(ctx context.Context, req resource.
 			},
 			"model_id": schema.StringAttribute{
 				Optional:            true,
-				MarkdownDescription: "The UUID of the model deployment to use for this capability. If not provided, a default model for 'completion' type may be used by the API.",
+				MarkdownDescription: "The UUID of the model deployment to use for this capability. Mutually exclusive with `model_pool_id`. If neither is provided, the default model for the 'completion' capability type is used by the API.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("model_pool_id")),
+				},
+			},
+			"model_pool_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The UUID of the model pool to route requests for this capability through. Mutually exclusive with `model_id`.",
+				Validators:          []validator.String{uuidValidator()},
 			},
 			"project_id": schema.StringAttribute{
 				Optional:            true,
@@ -209,11 +218,9 @@ func mapCompletionCapabilityRepresentationToModel(apiCap *api.CapabilityRepresen
 	model.IsPublic = types.BoolValue(apiCap.GetIsPublic())
 	model.Type = types.StringValue(apiCap.Type)
 
-	if modelId, ok := apiCap.GetModelIdOk(); ok && modelId != nil {
-		model.ModelID = types.StringValue(*modelId)
-	} else {
-		model.ModelID = types.StringNull()
-	}
+	modelId, _ := apiCap.GetModelIdOk()
+	modelPoolId, _ := apiCap.GetModelPoolIdOk()
+	model.ModelID, model.ModelPoolID = capabilityModelRoutingAPIToModel(modelId, modelPoolId)
 	if projectId, ok := apiCap.GetProjectIdOk(); ok && projectId != nil {
 		model.ProjectID = types.StringValue(*projectId)
 	} else {
@@ -384,11 +391,9 @@ func mapCompletionCapabilityCreateResponseToModel(apiCap *api.CompletionCapabili
 		model.Type = types.StringValue("completion")
 	}
 
-	if modelId, ok := apiCap.GetModelIdOk(); ok && modelId != nil {
-		model.ModelID = types.StringValue(*modelId)
-	} else {
-		model.ModelID = types.StringNull()
-	}
+	modelId, _ := apiCap.GetModelIdOk()
+	modelPoolId, _ := apiCap.GetModelPoolIdOk()
+	model.ModelID, model.ModelPoolID = capabilityModelRoutingAPIToModel(modelId, modelPoolId)
 	if projectId, ok := apiCap.GetProjectIdOk(); ok && projectId != nil {
 		model.ProjectID = types.StringValue(*projectId)
 	} else {
@@ -523,6 +528,9 @@ func (r *CompletionCapabilityResource) Create(ctx context.Context, req resource.
 	if !plan.ModelID.IsNull() && !plan.ModelID.IsUnknown() {
 		apiPayload.SetModelId(plan.ModelID.ValueString())
 	}
+	if !plan.ModelPoolID.IsNull() && !plan.ModelPoolID.IsUnknown() {
+		apiPayload.SetModelPoolId(plan.ModelPoolID.ValueString())
+	}
 	if !plan.ProjectID.IsNull() && !plan.ProjectID.IsUnknown() {
 		apiPayload.SetProjectId(plan.ProjectID.ValueString())
 	}
@@ -653,9 +661,18 @@ func (r *CompletionCapabilityResource) Update(ctx context.Context, req resource.
 		updatePayload.SetSemanticId(plan.SemanticID.ValueString())
 	}
 
-	// ModelID
+	// ModelID and ModelPoolID are mutually exclusive; explicitly clear whichever
+	// one is no longer configured so the server drops the previous routing.
 	if !plan.ModelID.IsNull() && !plan.ModelID.IsUnknown() {
 		updatePayload.SetModelId(plan.ModelID.ValueString())
+	} else if plan.ModelID.IsNull() {
+		updatePayload.SetModelIdNil()
+	}
+
+	if !plan.ModelPoolID.IsNull() && !plan.ModelPoolID.IsUnknown() {
+		updatePayload.SetModelPoolId(plan.ModelPoolID.ValueString())
+	} else if plan.ModelPoolID.IsNull() {
+		updatePayload.SetModelPoolIdNil()
 	}
 
 	// ProjectID
@@ -260,6 +260,53 @@ func TestAccCompletionCapabilityResource_basic(t *testing.T) {
 	})
 }
 
+func TestAccCompletionCapabilityResource_modelPool(t *testing.T) {
+	if os.Getenv("CORAX_API_ENDPOINT") == "" || os.Getenv("CORAX_API_KEY") == "" {
+		t.Skip("Skipping acceptance test: CORAX_API_ENDPOINT or CORAX_API_KEY not set")
+	}
+	testModelPoolID := os.Getenv(testAccChatCapabilityModelPoolIDEnvVar)
+	if testModelPoolID == "" {
+		t.Skipf("Skipping acceptance test: %s must be set with a valid Model Pool UUID", testAccChatCapabilityModelPoolIDEnvVar)
+	}
+	testModelID := os.Getenv(testAccCapabilityTypeDefaultModelDeploymentIDEnvVar)
+	if testModelID == "" {
+		t.Skipf("Skipping acceptance test: %s must be set with a valid Model Deployment UUID", testAccCapabilityTypeDefaultModelDeploymentIDEnvVar)
+	}
+
+	resourceName := "corax_completion_capability.test_routing"
+	capabilityName := "tf-acc-test-completion-routing"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCompletionCapabilityResourceWithRouting(capabilityName, "model_id", testModelID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "model_id", testModelID),
+					resource.TestCheckNoResourceAttr(resourceName, "model_pool_id"),
+				),
+			},
+			// Switch from a single model to pool routing
+			{
+				Config: testAccCompletionCapabilityResourceWithRouting(capabilityName, "model_pool_id", testModelPoolID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "model_pool_id", testModelPoolID),
+					resource.TestCheckNoResourceAttr(resourceName, "model_id"),
+				),
+			},
+			// Switch back from pool routing to a single model
+			{
+				Config: testAccCompletionCapabilityResourceWithRouting(capabilityName, "model_id", testModelID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "model_id", testModelID),
+					resource.TestCheckNoResourceAttr(resourceName, "model_pool_id"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccCompletionCapabilityResource_withSchemaOutput(t *testing.T) {
 	if os.Getenv("CORAX_API_ENDPOINT") == "" || os.Getenv("CORAX_API_KEY") == "" {
 		t.Skip("Skipping acceptance test: CORAX_API_ENDPOINT or CORAX_API_KEY not set")
@@ -470,3 +517,17 @@ resource "corax_completion_capability" "test_field_update" {
 }
 `, name, enumVal1, enumVal2)
 }
+
+func testAccCompletionCapabilityResourceWithRouting(name, routingAttr, routingID string) string {
+	return fmt.Sprintf(`
+provider "corax" {}
+
+resource "corax_completion_capability" "test_routing" {
+  name              = "%s"
+  system_prompt     = "You are a text completion model."
+  completion_prompt = "Continue: "
+  output_type       = "text"
+  %s = "%s"
+}
+`, name, routingAttr, routingID)
+}
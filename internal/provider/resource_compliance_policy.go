@@ -0,0 +1,801 @@
+// Copyright (c) Trifork
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-corax/internal/coraxclient"
+	api "terraform-provider-corax/internal/generated"
+)
+
+// Defaults the API applies to optional control settings. Unset settings are kept null in state
+// as long as the API reports these values.
+const (
+	compliancePolicyDefaultEnabled        = true
+	compliancePolicyDefaultTopicThreshold = 0.75
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CompliancePolicyResource{}
+var _ resource.ResourceWithImportState = &CompliancePolicyResource{}
+var _ resource.ResourceWithValidateConfig = &CompliancePolicyResource{}
+var _ resource.ResourceWithModifyPlan = &CompliancePolicyResource{}
+
+func NewCompliancePolicyResource() resource.Resource {
+	return &CompliancePolicyResource{}
+}
+
+// CompliancePolicyResource defines the resource implementation.
+type CompliancePolicyResource struct {
+	client *coraxclient.Client
+}
+
+// CompliancePolicyResourceModel describes the resource data model.
+// Based on components.schemas.PolicyResponse.
+type CompliancePolicyResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	Description      types.String `tfsdk:"description"` // Nullable
+	ScopeType        types.String `tfsdk:"scope_type"`
+	ScopeID          types.String `tfsdk:"scope_id"` // Nullable
+	IsActive         types.Bool   `tfsdk:"is_active"`
+	Exceptions       types.Set    `tfsdk:"exceptions"`        // Set of capability IDs
+	DataRetention    types.Object `tfsdk:"data_retention"`    // PolicyDataRetentionModel
+	ContentTracing   types.Object `tfsdk:"content_tracing"`   // PolicyContentTracingModel
+	ContentFilter    types.Object `tfsdk:"content_filter"`    // PolicyContentFilterModel
+	PromptShield     types.Object `tfsdk:"prompt_shield"`     // PolicyEnabledControlModel
+	PiiDetection     types.Object `tfsdk:"pii_detection"`     // PolicyPiiDetectionModel
+	TopicRestriction types.Object `tfsdk:"topic_restriction"` // PolicyTopicRestrictionModel
+	Groundedness     types.Object `tfsdk:"groundedness"`      // PolicyEnabledControlModel
+	CreatedAt        types.String `tfsdk:"created_at"`
+	CreatedBy        types.String `tfsdk:"created_by"`
+}
+
+// PolicyDataRetentionModel maps to components.schemas.DataRetentionRequirement.
+type PolicyDataRetentionModel struct {
+	RequiredType types.String `tfsdk:"required_type"`
+	MinimumHours types.Int64  `tfsdk:"minimum_hours"`
+}
+
+// PolicyContentTracingModel maps to components.schemas.ContentTracingRequirement.
+type PolicyContentTracingModel struct {
+	Required types.Bool `tfsdk:"required"`
+}
+
+// PolicyContentFilterModel maps to components.schemas.ContentFilterRequirement.
+type PolicyContentFilterModel struct {
+	Enabled            types.Bool `tfsdk:"enabled"`
+	SeverityThresholds types.Map  `tfsdk:"severity_thresholds"` // Map of category to severity level
+}
+
+// PolicyEnabledControlModel maps to controls that can only be switched on or off
+// (components.schemas.PromptShieldRequirement and components.schemas.GroundednessRequirement).
+type PolicyEnabledControlModel struct {
+	Enabled types.Bool `tfsdk:"enabled"`
+}
+
+// PolicyPiiDetectionModel maps to components.schemas.PiiDetectionRequirement-Input.
+type PolicyPiiDetectionModel struct {
+	Enabled    types.Bool   `tfsdk:"enabled"`
+	InputMode  types.String `tfsdk:"input_mode"`
+	OutputMode types.String `tfsdk:"output_mode"`
+}
+
+// PolicyTopicRestrictionModel maps to components.schemas.TopicRestrictionRequirement.
+type PolicyTopicRestrictionModel struct {
+	Enabled   types.Bool    `tfsdk:"enabled"`
+	Mode      types.String  `tfsdk:"mode"`
+	Topics    types.List    `tfsdk:"topics"` // List of strings
+	Threshold types.Float64 `tfsdk:"threshold"`
+}
+
+func policyDataRetentionAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"required_type": types.StringType,
+		"minimum_hours": types.Int64Type,
+	}
+}
+
+func policyContentTracingAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"required": types.BoolType,
+	}
+}
+
+func policyContentFilterAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"enabled":             types.BoolType,
+		"severity_thresholds": types.MapType{ElemType: types.StringType},
+	}
+}
+
+func policyEnabledControlAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"enabled": types.BoolType,
+	}
+}
+
+func policyPiiDetectionAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"enabled":     types.BoolType,
+		"input_mode":  types.StringType,
+		"output_mode": types.StringType,
+	}
+}
+
+func policyTopicRestrictionAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"enabled":   types.BoolType,
+		"mode":      types.StringType,
+		"topics":    types.ListType{ElemType: types.StringType},
+		"threshold": types.Float64Type,
+	}
+}
+
+// policyEnabledToAPI returns the enabled flag for the API, nil when unset so the API default applies.
+func policyEnabledToAPI(enabled types.Bool) *bool {
+	if enabled.IsNull() || enabled.IsUnknown() {
+		return nil
+	}
+	v := enabled.ValueBool()
+	return &v
+}
+
+// policyEnabledAPIToModel maps the enabled flag from the API, keeping it null when it was unset
+// and the API reports the default.
+func policyEnabledAPIToModel(current types.Bool, apiValue *bool) types.Bool {
+	enabled := compliancePolicyDefaultEnabled
+	if apiValue != nil {
+		enabled = *apiValue
+	}
+	if current.IsNull() && enabled == compliancePolicyDefaultEnabled {
+		return types.BoolNull()
+	}
+	return types.BoolValue(enabled)
+}
+
+// compliancePolicyControlsToAPI builds the policy controls from the model. Controls without a block
+// are sent as null, so removing a block removes the control.
+func compliancePolicyControlsToAPI(ctx context.Context, model CompliancePolicyResourceModel, diags *diag.Diagnostics) api.PolicyControlsInput {
+	controls := api.NewPolicyControlsInput()
+	opts := basetypes.ObjectAsOptions{}
+
+	controls.SetDataRetentionNil()
+	if !model.DataRetention.IsNull() && !model.DataRetention.IsUnknown() {
+		var m PolicyDataRetentionModel
+		diags.Append(model.DataRetention.As(ctx, &m, opts)...)
+		requirement := api.NewDataRetentionRequirement(m.RequiredType.ValueString())
+		if !m.MinimumHours.IsNull() && !m.MinimumHours.IsUnknown() {
+			requirement.SetMinimumHours(int32(m.MinimumHours.ValueInt64()))
+		}
+		controls.SetDataRetention(*requirement)
+	}
+
+	controls.SetContentTracingNil()
+	if !model.ContentTracing.IsNull() && !model.ContentTracing.IsUnknown() {
+		var m PolicyContentTracingModel
+		diags.Append(model.ContentTracing.As(ctx, &m, opts)...)
+		controls.SetContentTracing(*api.NewContentTracingRequirement(m.Required.ValueBool()))
+	}
+
+	controls.SetContentFilterNil()
+	if !model.ContentFilter.IsNull() && !model.ContentFilter.IsUnknown() {
+		var m PolicyContentFilterModel
+		diags.Append(model.ContentFilter.As(ctx, &m, opts)...)
+		var thresholds map[string]string
+		diags.Append(m.SeverityThresholds.ElementsAs(ctx, &thresholds, false)...)
+		severityThresholds := make(map[string]api.SeverityLevel, len(thresholds))
+		for category, level := range thresholds {
+			severityThresholds[category] = api.SeverityLevel(level)
+		}
+		requirement := api.NewContentFilterRequirement(severityThresholds)
+		requirement.Enabled = policyEnabledToAPI(m.Enabled)
+		controls.SetContentFilter(*requirement)
+	}
+
+	controls.SetPromptShieldNil()
+	if !model.PromptShield.IsNull() && !model.PromptShield.IsUnknown() {
+		var m PolicyEnabledControlModel
+		diags.Append(model.PromptShield.As(ctx, &m, opts)...)
+		requirement := api.NewPromptShieldRequirementWithDefaults()
+		requirement.Enabled = policyEnabledToAPI(m.Enabled)
+		controls.SetPromptShield(*requirement)
+	}
+
+	controls.SetPiiDetectionNil()
+	if !model.PiiDetection.IsNull() && !model.PiiDetection.IsUnknown() {
+		var m PolicyPiiDetectionModel
+		diags.Append(model.PiiDetection.As(ctx, &m, opts)...)
+		requirement := api.NewPiiDetectionRequirementInput(
+			*api.NewPiiDetectionDirectionRequirement(api.PiiEnforcementMode(m.InputMode.ValueString())),
+			*api.NewPiiDetectionDirectionRequirement(api.PiiEnforcementMode(m.OutputMode.ValueString())),
+		)
+		requirement.Enabled = policyEnabledToAPI(m.Enabled)
+		controls.SetPiiDetection(*requirement)
+	}
+
+	controls.SetTopicRestrictionNil()
+	if !model.TopicRestriction.IsNull() && !model.TopicRestriction.IsUnknown() {
+		var m PolicyTopicRestrictionModel
+		diags.Append(model.TopicRestriction.As(ctx, &m, opts)...)
+		var topics []string
+		diags.Append(m.Topics.ElementsAs(ctx, &topics, false)...)
+		requirement := api.NewTopicRestrictionRequirement(api.TopicRestrictionMode(m.Mode.ValueString()), topics)
+		requirement.Enabled = policyEnabledToAPI(m.Enabled)
+		if !m.Threshold.IsNull() && !m.Threshold.IsUnknown() {
+			requirement.SetThreshold(float32(m.Threshold.ValueFloat64()))
+		}
+		controls.SetTopicRestriction(*requirement)
+	}
+
+	controls.SetGroundednessNil()
+	if !model.Groundedness.IsNull() && !model.Groundedness.IsUnknown() {
+		var m PolicyEnabledControlModel
+		diags.Append(model.Groundedness.As(ctx, &m, opts)...)
+		requirement := api.NewGroundednessRequirementWithDefaults()
+		requirement.Enabled = policyEnabledToAPI(m.Enabled)
+		controls.SetGroundedness(*requirement)
+	}
+
+	return *controls
+}
+
+// compliancePolicyControlsToModel maps the policy controls from the API to the model. The current
+// model values are used to keep settings that were left unset null.
+func compliancePolicyControlsToModel(ctx context.Context, controls api.PolicyControlsOutput, model *CompliancePolicyResourceModel, diags *diag.Diagnostics) {
+	opts := basetypes.ObjectAsOptions{}
+
+	if dataRetention, ok := controls.GetDataRetentionOk(); ok && dataRetention != nil {
+		m := PolicyDataRetentionModel{
+			RequiredType: types.StringValue(dataRetention.RequiredType),
+			MinimumHours: types.Int64Null(),
+		}
+		if hours, ok := dataRetention.GetMinimumHoursOk(); ok && hours != nil {
+			m.MinimumHours = types.Int64Value(int64(*hours))
+		}
+		objVal, objDiags := types.ObjectValueFrom(ctx, policyDataRetentionAttributeTypes(), m)
+		diags.Append(objDiags...)
+		model.DataRetention = objVal
+	} else {
+		model.DataRetention = types.ObjectNull(policyDataRetentionAttributeTypes())
+	}
+
+	if contentTracing, ok := controls.GetContentTracingOk(); ok && contentTracing != nil {
+		objVal, objDiags := types.ObjectValueFrom(ctx, policyContentTracingAttributeTypes(), PolicyContentTracingModel{
+			Required: types.BoolValue(contentTracing.Required),
+		})
+		diags.Append(objDiags...)
+		model.ContentTracing = objVal
+	} else {
+		model.ContentTracing = types.ObjectNull(policyContentTracingAttributeTypes())
+	}
+
+	if contentFilter, ok := controls.GetContentFilterOk(); ok && contentFilter != nil {
+		current := PolicyContentFilterModel{Enabled: types.BoolNull()}
+		if !model.ContentFilter.IsNull() && !model.ContentFilter.IsUnknown() {
+			diags.Append(model.ContentFilter.As(ctx, &current, opts)...)
+		}
+		thresholds := make(map[string]string, len(contentFilter.SeverityThresholds))
+		for category, level := range contentFilter.SeverityThresholds {
+			thresholds[category] = string(level)
+		}
+		thresholdsVal, mapDiags := types.MapValueFrom(ctx, types.StringType, thresholds)
+		diags.Append(mapDiags...)
+		objVal, objDiags := types.ObjectValueFrom(ctx, policyContentFilterAttributeTypes(), PolicyContentFilterModel{
+			Enabled:            policyEnabledAPIToModel(current.Enabled, contentFilter.Enabled),
+			SeverityThresholds: thresholdsVal,
+		})
+		diags.Append(objDiags...)
+		model.ContentFilter = objVal
+	} else {
+		model.ContentFilter = types.ObjectNull(policyContentFilterAttributeTypes())
+	}
+
+	if promptShield, ok := controls.GetPromptShieldOk(); ok && promptShield != nil {
+		current := PolicyEnabledControlModel{Enabled: types.BoolNull()}
+		if !model.PromptShield.IsNull() && !model.PromptShield.IsUnknown() {
+			diags.Append(model.PromptShield.As(ctx, &current, opts)...)
+		}
+		objVal, objDiags := types.ObjectValueFrom(ctx, policyEnabledControlAttributeTypes(), PolicyEnabledControlModel{
+			Enabled: policyEnabledAPIToModel(current.Enabled, promptShield.Enabled),
+		})
+		diags.Append(objDiags...)
+		model.PromptShield = objVal
+	} else {
+		model.PromptShield = types.ObjectNull(policyEnabledControlAttributeTypes())
+	}
+
+	if piiDetection, ok := controls.GetPiiDetectionOk(); ok && piiDetection != nil {
+		current := PolicyPiiDetectionModel{Enabled: types.BoolNull()}
+		if !model.PiiDetection.IsNull() && !model.PiiDetection.IsUnknown() {
+			diags.Append(model.PiiDetection.As(ctx, &current, opts)...)
+		}
+		objVal, objDiags := types.ObjectValueFrom(ctx, policyPiiDetectionAttributeTypes(), PolicyPiiDetectionModel{
+			Enabled:    policyEnabledAPIToModel(current.Enabled, piiDetection.Enabled),
+			InputMode:  types.StringValue(string(piiDetection.Input.Mode)),
+			OutputMode: types.StringValue(string(piiDetection.Output.Mode)),
+		})
+		diags.Append(objDiags...)
+		model.PiiDetection = objVal
+	} else {
+		model.PiiDetection = types.ObjectNull(policyPiiDetectionAttributeTypes())
+	}
+
+	if topicRestriction, ok := controls.GetTopicRestrictionOk(); ok && topicRestriction != nil {
+		current := PolicyTopicRestrictionModel{Enabled: types.BoolNull(), Threshold: types.Float64Null()}
+		if !model.TopicRestriction.IsNull() && !model.TopicRestriction.IsUnknown() {
+			diags.Append(model.TopicRestriction.As(ctx, &current, opts)...)
+		}
+		topicsVal, listDiags := types.ListValueFrom(ctx, types.StringType, topicRestriction.Topics)
+		diags.Append(listDiags...)
+		threshold := types.Float64Null()
+		if topicRestriction.Threshold != nil {
+			value := float32ToFloat64(*topicRestriction.Threshold)
+			if !current.Threshold.IsNull() || value != compliancePolicyDefaultTopicThreshold {
+				threshold = types.Float64Value(value)
+			}
+		}
+		objVal, objDiags := types.ObjectValueFrom(ctx, policyTopicRestrictionAttributeTypes(), PolicyTopicRestrictionModel{
+			Enabled:   policyEnabledAPIToModel(current.Enabled, topicRestriction.Enabled),
+			Mode:      types.StringValue(string(topicRestriction.Mode)),
+			Topics:    topicsVal,
+			Threshold: threshold,
+		})
+		diags.Append(objDiags...)
+		model.TopicRestriction = objVal
+	} else {
+		model.TopicRestriction = types.ObjectNull(policyTopicRestrictionAttributeTypes())
+	}
+
+	if groundedness, ok := controls.GetGroundednessOk(); ok && groundedness != nil {
+		current := PolicyEnabledControlModel{Enabled: types.BoolNull()}
+		if !model.Groundedness.IsNull() && !model.Groundedness.IsUnknown() {
+			diags.Append(model.Groundedness.As(ctx, &current, opts)...)
+		}
+		objVal, objDiags := types.ObjectValueFrom(ctx, policyEnabledControlAttributeTypes(), PolicyEnabledControlModel{
+			Enabled: policyEnabledAPIToModel(current.Enabled, groundedness.Enabled),
+		})
+		diags.Append(objDiags...)
+		model.Groundedness = objVal
+	} else {
+		model.Groundedness = types.ObjectNull(policyEnabledControlAttributeTypes())
+	}
+}
+
+// mapCompliancePolicyToModel maps an api.PolicyResponse to the Terraform model.
+func mapCompliancePolicyToModel(ctx context.Context, policy *api.PolicyResponse, model *CompliancePolicyResourceModel, diags *diag.Diagnostics) {
+	model.ID = types.StringValue(policy.Id)
+	model.Name = types.StringValue(policy.Name)
+	if description, ok := policy.GetDescriptionOk(); ok && description != nil && *description != "" {
+		model.Description = types.StringValue(*description)
+	} else {
+		model.Description = types.StringNull()
+	}
+	model.ScopeType = types.StringValue(string(policy.ScopeType))
+	if scopeID, ok := policy.GetScopeIdOk(); ok && scopeID != nil && *scopeID != "" {
+		model.ScopeID = types.StringValue(*scopeID)
+	} else {
+		model.ScopeID = types.StringNull()
+	}
+	model.IsActive = types.BoolValue(policy.IsActive)
+
+	if len(policy.Exceptions) > 0 {
+		setVal, setDiags := types.SetValueFrom(ctx, types.StringType, policy.Exceptions)
+		diags.Append(setDiags...)
+		model.Exceptions = setVal
+	} else if model.Exceptions.IsNull() || model.Exceptions.IsUnknown() {
+		model.Exceptions = types.SetNull(types.StringType)
+	} else {
+		model.Exceptions = types.SetValueMust(types.StringType, []attr.Value{})
+	}
+
+	compliancePolicyControlsToModel(ctx, policy.Controls, model, diags)
+
+	model.CreatedAt = types.StringValue(policy.CreatedAt.Format(time.RFC3339))
+	model.CreatedBy = types.StringValue(policy.CreatedBy)
+}
+
+// compliancePolicyExceptions returns the exceptions of the model, or an empty list when unset.
+func compliancePolicyExceptions(ctx context.Context, model CompliancePolicyResourceModel, diags *diag.Diagnostics) []string {
+	exceptions := []string{}
+	if !model.Exceptions.IsNull() && !model.Exceptions.IsUnknown() {
+		diags.Append(model.Exceptions.ElementsAs(ctx, &exceptions, false)...)
+	}
+	return exceptions
+}
+
+func (r *CompliancePolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_compliance_policy"
+}
+
+func policyEnabledAttribute(control string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Optional:            true,
+		MarkdownDescription: fmt.Sprintf("Whether %s is enforced. Defaults to true.", control),
+	}
+}
+
+func (r *CompliancePolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Corax compliance policy. A policy defines the guardrail controls that apply to the capabilities in its scope. " +
+			"Each control is configured with its own block; omitting a block removes the control from the policy.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier for the policy (UUID).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the policy.",
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "An optional description of the policy.",
+			},
+			"scope_type": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The scope the policy applies to: `global`, `project` or `capability`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(enumStrings(api.AllowedPolicyScopeTypeEnumValues)...),
+				},
+			},
+			"scope_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The UUID of the project or capability the policy applies to. Required unless `scope_type` is `global`.",
+				Validators:          []validator.String{uuidValidator()},
+			},
+			"is_active": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Whether the policy is enforced. Defaults to true.",
+			},
+			"exceptions": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "UUIDs of capabilities that are exempt from the policy.",
+				Validators:          []validator.Set{setvalidator.ValueStringsAre(uuidValidator())},
+			},
+			"created_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The date and time the policy was created (RFC3339 format).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_by": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the user who created the policy.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"data_retention": schema.SingleNestedBlock{
+				MarkdownDescription: "Requires capabilities to use a specific data retention.",
+				Attributes: map[string]schema.Attribute{
+					"required_type": schema.StringAttribute{
+						Optional:            true, // Required when the block is present, see ValidateConfig
+						MarkdownDescription: "The required retention type: `infinite` or `timed`.",
+						Validators:          []validator.String{stringvalidator.OneOf("infinite", "timed")},
+					},
+					"minimum_hours": schema.Int64Attribute{
+						Optional:            true,
+						MarkdownDescription: "The minimum number of hours data must be retained for `timed` retention.",
+						Validators:          []validator.Int64{int64validator.AtLeast(0)},
+					},
+				},
+			},
+			"content_tracing": schema.SingleNestedBlock{
+				MarkdownDescription: "Requires or forbids content tracing on capabilities.",
+				Attributes: map[string]schema.Attribute{
+					"required": schema.BoolAttribute{
+						Optional:            true, // Required when the block is present, see ValidateConfig
+						MarkdownDescription: "Whether content tracing is required.",
+					},
+				},
+			},
+			"content_filter": schema.SingleNestedBlock{
+				MarkdownDescription: "Filters harmful content above a severity per category.",
+				Attributes: map[string]schema.Attribute{
+					"enabled": policyEnabledAttribute("the content filter"),
+					"severity_thresholds": schema.MapAttribute{
+						ElementType:         types.StringType,
+						Optional:            true, // Required when the block is present, see ValidateConfig
+						MarkdownDescription: "Severity threshold (`low`, `medium` or `high`) per content category (`violence`, `sexual`, `hate` or `self_harm`).",
+						Validators: []validator.Map{
+							mapvalidator.KeysAre(stringvalidator.OneOf(enumStrings(api.AllowedContentFilterCategoryEnumValues)...)),
+							mapvalidator.ValueStringsAre(stringvalidator.OneOf(enumStrings(api.AllowedSeverityLevelEnumValues)...)),
+						},
+					},
+				},
+			},
+			"prompt_shield": schema.SingleNestedBlock{
+				MarkdownDescription: "Detects prompt injection and jailbreak attempts.",
+				Attributes: map[string]schema.Attribute{
+					"enabled": policyEnabledAttribute("the prompt shield"),
+				},
+			},
+			"pii_detection": schema.SingleNestedBlock{
+				MarkdownDescription: "Detects personally identifiable information in inputs and outputs.",
+				Attributes: map[string]schema.Attribute{
+					"enabled": policyEnabledAttribute("PII detection"),
+					"input_mode": schema.StringAttribute{
+						Optional:            true, // Required when the block is present, see ValidateConfig
+						MarkdownDescription: "How PII in inputs is handled: `allow`, `block` or `redact`.",
+						Validators:          []validator.String{stringvalidator.OneOf(enumStrings(api.AllowedPiiEnforcementModeEnumValues)...)},
+					},
+					"output_mode": schema.StringAttribute{
+						Optional:            true, // Required when the block is present, see ValidateConfig
+						MarkdownDescription: "How PII in outputs is handled: `allow`, `block` or `redact`.",
+						Validators:          []validator.String{stringvalidator.OneOf(enumStrings(api.AllowedPiiEnforcementModeEnumValues)...)},
+					},
+				},
+			},
+			"topic_restriction": schema.SingleNestedBlock{
+				MarkdownDescription: "Restricts the topics capabilities may discuss.",
+				Attributes: map[string]schema.Attribute{
+					"enabled": policyEnabledAttribute("the topic restriction"),
+					"mode": schema.StringAttribute{
+						Optional:            true, // Required when the block is present, see ValidateConfig
+						MarkdownDescription: "`allowlist` to only allow the topics, `blocklist` to block them.",
+						Validators:          []validator.String{stringvalidator.OneOf(enumStrings(api.AllowedTopicRestrictionModeEnumValues)...)},
+					},
+					"topics": schema.ListAttribute{
+						ElementType:         types.StringType,
+						Optional:            true, // Required when the block is present, see ValidateConfig
+						MarkdownDescription: "The topics to allow or block.",
+						Validators:          []validator.List{listvalidator.SizeAtLeast(1)},
+					},
+					"threshold": schema.Float64Attribute{
+						Optional:            true,
+						MarkdownDescription: "Confidence threshold (0.0 to 1.0) for topic classification. Higher means fewer false positives. Defaults to 0.75.",
+						Validators:          []validator.Float64{float64validator.Between(0, 1)},
+					},
+				},
+			},
+			"groundedness": schema.SingleNestedBlock{
+				MarkdownDescription: "Checks that outputs are grounded in the provided sources.",
+				Attributes: map[string]schema.Attribute{
+					"enabled": policyEnabledAttribute("the groundedness check"),
+				},
+			},
+		},
+	}
+}
+
+func (r *CompliancePolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*coraxclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *coraxclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+// requireBlockAttribute reports an error when a block is present but one of its required attributes is not set.
+func requireBlockAttribute(block types.Object, attribute string, diags *diag.Diagnostics, blockName string) {
+	if block.IsNull() || block.IsUnknown() {
+		return
+	}
+	value, ok := block.Attributes()[attribute]
+	if ok && value.IsNull() {
+		diags.AddAttributeError(path.Root(blockName).AtName(attribute), "Missing Required Attribute",
+			fmt.Sprintf("The argument %q is required in a %s block.", attribute, blockName))
+	}
+}
+
+func (r *CompliancePolicyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data CompliancePolicyResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.ScopeType.IsUnknown() && !data.ScopeID.IsUnknown() {
+		global := data.ScopeType.ValueString() == string(api.GLOBAL)
+		if global && !data.ScopeID.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("scope_id"), "Unexpected Scope ID",
+				"scope_id can't be set when scope_type is \"global\".")
+		}
+		if !global && !data.ScopeType.IsNull() && data.ScopeID.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("scope_id"), "Missing Scope ID",
+				fmt.Sprintf("scope_id is required when scope_type is %q.", data.ScopeType.ValueString()))
+		}
+	}
+
+	requireBlockAttribute(data.DataRetention, "required_type", &resp.Diagnostics, "data_retention")
+	requireBlockAttribute(data.ContentTracing, "required", &resp.Diagnostics, "content_tracing")
+	requireBlockAttribute(data.ContentFilter, "severity_thresholds", &resp.Diagnostics, "content_filter")
+	requireBlockAttribute(data.PiiDetection, "input_mode", &resp.Diagnostics, "pii_detection")
+	requireBlockAttribute(data.PiiDetection, "output_mode", &resp.Diagnostics, "pii_detection")
+	requireBlockAttribute(data.TopicRestriction, "mode", &resp.Diagnostics, "topic_restriction")
+	requireBlockAttribute(data.TopicRestriction, "topics", &resp.Diagnostics, "topic_restriction")
+}
+
+// ModifyPlan refuses the resource when the server has the compliance feature disabled.
+func (r *CompliancePolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	requireFeature(r.client, coraxclient.FeatureCompliance, "corax_compliance_policy", &resp.Diagnostics)
+}
+
+func (r *CompliancePolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CompliancePolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating Compliance Policy with name: %s", data.Name.ValueString()))
+
+	controls := compliancePolicyControlsToAPI(ctx, data, &resp.Diagnostics)
+	exceptions := compliancePolicyExceptions(ctx, data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createPayload := api.NewPolicyCreate(data.Name.ValueString(), api.PolicyScopeType(data.ScopeType.ValueString()), controls)
+	if !data.Description.IsNull() && !data.Description.IsUnknown() {
+		createPayload.SetDescription(data.Description.ValueString())
+	}
+	if !data.ScopeID.IsNull() && !data.ScopeID.IsUnknown() {
+		createPayload.SetScopeId(data.ScopeID.ValueString())
+	}
+	if !data.IsActive.IsNull() && !data.IsActive.IsUnknown() {
+		createPayload.SetIsActive(data.IsActive.ValueBool())
+	}
+	if len(exceptions) > 0 {
+		createPayload.SetExceptions(exceptions)
+	}
+
+	createdPolicy, err := r.client.CreateCompliancePolicy(ctx, *createPayload)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create compliance policy, got error: %s", err))
+		return
+	}
+
+	mapCompliancePolicyToModel(ctx, createdPolicy, &data, &resp.Diagnostics)
+	tflog.Info(ctx, fmt.Sprintf("Compliance Policy created successfully with ID: %s", createdPolicy.Id))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CompliancePolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CompliancePolicyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policyID := data.ID.ValueString()
+	tflog.Debug(ctx, fmt.Sprintf("Reading Compliance Policy with ID: %s", policyID))
+
+	policy, err := r.client.GetCompliancePolicy(ctx, policyID)
+	if err != nil {
+		if errors.Is(err, coraxclient.ErrNotFound) {
+			tflog.Warn(ctx, fmt.Sprintf("Compliance Policy with ID %s not found, removing from state", policyID))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read compliance policy %s, got error: %s", policyID, err))
+		return
+	}
+
+	mapCompliancePolicyToModel(ctx, policy, &data, &resp.Diagnostics)
+	tflog.Debug(ctx, fmt.Sprintf("Successfully read Compliance Policy with ID: %s", policyID))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CompliancePolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan CompliancePolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state CompliancePolicyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policyID := state.ID.ValueString() // ID comes from state, not plan
+	tflog.Debug(ctx, fmt.Sprintf("Updating Compliance Policy with ID: %s", policyID))
+
+	controls := compliancePolicyControlsToAPI(ctx, plan, &resp.Diagnostics)
+	exceptions := compliancePolicyExceptions(ctx, plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// All fields are sent so that unset optional values are cleared.
+	updatePayload := api.NewPolicyUpdate()
+	updatePayload.SetName(plan.Name.ValueString())
+	if !plan.Description.IsNull() && !plan.Description.IsUnknown() {
+		updatePayload.SetDescription(plan.Description.ValueString())
+	} else {
+		updatePayload.SetDescriptionNil()
+	}
+	updatePayload.SetScopeType(api.PolicyScopeType(plan.ScopeType.ValueString()))
+	if !plan.ScopeID.IsNull() && !plan.ScopeID.IsUnknown() {
+		updatePayload.SetScopeId(plan.ScopeID.ValueString())
+	} else {
+		updatePayload.SetScopeIdNil()
+	}
+	updatePayload.SetControls(controls)
+	updatePayload.SetIsActive(plan.IsActive.ValueBool())
+	updatePayload.SetExceptions(exceptions)
+
+	updatedPolicy, err := r.client.UpdateCompliancePolicy(ctx, policyID, *updatePayload)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update compliance policy %s, got error: %s", policyID, err))
+		return
+	}
+
+	mapCompliancePolicyToModel(ctx, updatedPolicy, &plan, &resp.Diagnostics) // Update plan with response
+	tflog.Info(ctx, fmt.Sprintf("Compliance Policy updated successfully with ID: %s", policyID))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *CompliancePolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CompliancePolicyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policyID := data.ID.ValueString()
+	tflog.Debug(ctx, fmt.Sprintf("Deleting Compliance Policy with ID: %s", policyID))
+
+	err := r.client.DeleteCompliancePolicy(ctx, policyID)
+	if err != nil {
+		if errors.Is(err, coraxclient.ErrNotFound) {
+			tflog.Warn(ctx, fmt.Sprintf("Compliance Policy with ID %s already deleted, removing from state", policyID))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete compliance policy %s, got error: %s", policyID, err))
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Compliance Policy with ID %s deleted successfully", policyID))
+}
+
+func (r *CompliancePolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,261 @@
+// Copyright (c) Trifork
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	api "terraform-provider-corax/internal/generated"
+)
+
+func TestAccCompliancePolicyResource_basic(t *testing.T) {
+	if os.Getenv("CORAX_API_ENDPOINT") == "" || os.Getenv("CORAX_API_KEY") == "" {
+		t.Skip("Skipping acceptance test: CORAX_API_ENDPOINT or CORAX_API_KEY not set")
+	}
+
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	name := fmt.Sprintf("tf-acc-test-policy-%s", rName)
+	resourceName := "corax_compliance_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing with all controls
+			{
+				Config: testAccCompliancePolicyResourceAllControlsConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "scope_type", "project"),
+					resource.TestCheckResourceAttrPair(resourceName, "scope_id", "corax_project.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "is_active", "false"),
+					resource.TestCheckResourceAttr(resourceName, "data_retention.required_type", "timed"),
+					resource.TestCheckResourceAttr(resourceName, "data_retention.minimum_hours", "24"),
+					resource.TestCheckResourceAttr(resourceName, "content_tracing.required", "true"),
+					resource.TestCheckResourceAttr(resourceName, "content_filter.severity_thresholds.violence", "low"),
+					resource.TestCheckResourceAttr(resourceName, "content_filter.severity_thresholds.hate", "medium"),
+					resource.TestCheckResourceAttr(resourceName, "prompt_shield.enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "pii_detection.input_mode", "redact"),
+					resource.TestCheckResourceAttr(resourceName, "pii_detection.output_mode", "block"),
+					resource.TestCheckResourceAttr(resourceName, "topic_restriction.mode", "blocklist"),
+					resource.TestCheckResourceAttr(resourceName, "topic_restriction.topics.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "topic_restriction.threshold", "0.8"),
+					resource.TestCheckResourceAttr(resourceName, "groundedness.enabled", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update to a global policy with a single control
+			{
+				Config: testAccCompliancePolicyResourceMinimalConfig(name + "-updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name+"-updated"),
+					resource.TestCheckResourceAttr(resourceName, "scope_type", "global"),
+					resource.TestCheckNoResourceAttr(resourceName, "scope_id"),
+					resource.TestCheckResourceAttr(resourceName, "is_active", "true"),
+					resource.TestCheckNoResourceAttr(resourceName, "prompt_shield.enabled"),
+					resource.TestCheckNoResourceAttr(resourceName, "data_retention.required_type"),
+					resource.TestCheckNoResourceAttr(resourceName, "topic_restriction.mode"),
+				),
+			},
+			// ImportState testing of the minimal policy
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCompliancePolicyResourceAllControlsConfig(name string) string {
+	return fmt.Sprintf(`
+provider "corax" {}
+
+resource "corax_project" "test" {
+  name = %[1]q
+}
+
+resource "corax_compliance_policy" "test" {
+  name        = %[1]q
+  description = "All controls"
+  scope_type  = "project"
+  scope_id    = corax_project.test.id
+  is_active   = false
+
+  data_retention {
+    required_type = "timed"
+    minimum_hours = 24
+  }
+
+  content_tracing {
+    required = true
+  }
+
+  content_filter {
+    severity_thresholds = {
+      violence = "low"
+      hate     = "medium"
+    }
+  }
+
+  prompt_shield {
+    enabled = false
+  }
+
+  pii_detection {
+    input_mode  = "redact"
+    output_mode = "block"
+  }
+
+  topic_restriction {
+    mode      = "blocklist"
+    topics    = ["politics", "medical advice"]
+    threshold = 0.8
+  }
+
+  groundedness {
+    enabled = false
+  }
+}
+`, name)
+}
+
+func testAccCompliancePolicyResourceMinimalConfig(name string) string {
+	return fmt.Sprintf(`
+provider "corax" {}
+
+resource "corax_project" "test" {
+  name = %[1]q
+}
+
+resource "corax_compliance_policy" "test" {
+  name       = %[1]q
+  scope_type = "global"
+
+  prompt_shield {}
+}
+`, name)
+}
+
+// TestCompliancePolicyControlsRoundTrip converts controls to the API payload, serializes it the way
+// the API echoes it back, and maps the response to the model again.
+func TestCompliancePolicyControlsRoundTrip(t *testing.T) {
+	ctx := context.Background()
+
+	model := CompliancePolicyResourceModel{
+		DataRetention: types.ObjectValueMust(policyDataRetentionAttributeTypes(), map[string]attr.Value{
+			"required_type": types.StringValue("timed"),
+			"minimum_hours": types.Int64Value(48),
+		}),
+		ContentTracing: types.ObjectValueMust(policyContentTracingAttributeTypes(), map[string]attr.Value{
+			"required": types.BoolValue(false),
+		}),
+		ContentFilter: types.ObjectValueMust(policyContentFilterAttributeTypes(), map[string]attr.Value{
+			"enabled": types.BoolNull(),
+			"severity_thresholds": types.MapValueMust(types.StringType, map[string]attr.Value{
+				"violence":  types.StringValue("high"),
+				"self_harm": types.StringValue("low"),
+			}),
+		}),
+		PromptShield: types.ObjectNull(policyEnabledControlAttributeTypes()),
+		PiiDetection: types.ObjectValueMust(policyPiiDetectionAttributeTypes(), map[string]attr.Value{
+			"enabled":     types.BoolValue(true),
+			"input_mode":  types.StringValue("allow"),
+			"output_mode": types.StringValue("redact"),
+		}),
+		TopicRestriction: types.ObjectValueMust(policyTopicRestrictionAttributeTypes(), map[string]attr.Value{
+			"enabled":   types.BoolNull(),
+			"mode":      types.StringValue("allowlist"),
+			"topics":    types.ListValueMust(types.StringType, []attr.Value{types.StringValue("billing")}),
+			"threshold": types.Float64Null(),
+		}),
+		Groundedness: types.ObjectValueMust(policyEnabledControlAttributeTypes(), map[string]attr.Value{
+			"enabled": types.BoolValue(false),
+		}),
+	}
+
+	var diags diag.Diagnostics
+	controls := compliancePolicyControlsToAPI(ctx, model, &diags)
+	if diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+
+	payload, err := json.Marshal(controls)
+	if err != nil {
+		t.Fatalf("Failed to marshal controls: %v", err)
+	}
+	var sent map[string]interface{}
+	if err := json.Unmarshal(payload, &sent); err != nil {
+		t.Fatalf("Failed to unmarshal controls: %v", err)
+	}
+	if value, ok := sent["prompt_shield"]; !ok || value != nil {
+		t.Errorf("Expected prompt_shield to be sent as null, got %v", sent["prompt_shield"])
+	}
+	if _, ok := sent["content_filter"].(map[string]interface{})["enabled"]; ok {
+		t.Errorf("Expected unset enabled to be omitted, got %v", sent["content_filter"])
+	}
+
+	// The API fills in defaults for unset settings.
+	sent["content_filter"].(map[string]interface{})["enabled"] = true
+	sent["topic_restriction"].(map[string]interface{})["enabled"] = true
+	sent["topic_restriction"].(map[string]interface{})["threshold"] = 0.75
+	echoed, _ := json.Marshal(sent)
+	var response api.PolicyControlsOutput
+	if err := json.Unmarshal(echoed, &response); err != nil {
+		t.Fatalf("Failed to unmarshal response controls: %v", err)
+	}
+
+	result := model
+	compliancePolicyControlsToModel(ctx, response, &result, &diags)
+	if diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+
+	for name, pair := range map[string][2]types.Object{
+		"data_retention":    {model.DataRetention, result.DataRetention},
+		"content_tracing":   {model.ContentTracing, result.ContentTracing},
+		"content_filter":    {model.ContentFilter, result.ContentFilter},
+		"prompt_shield":     {model.PromptShield, result.PromptShield},
+		"pii_detection":     {model.PiiDetection, result.PiiDetection},
+		"topic_restriction": {model.TopicRestriction, result.TopicRestriction},
+		"groundedness":      {model.Groundedness, result.Groundedness},
+	} {
+		if !pair[0].Equal(pair[1]) {
+			t.Errorf("%s did not round-trip:\nexpected %s\ngot      %s", name, pair[0], pair[1])
+		}
+	}
+}
+
+func TestPolicyEnabledAPIToModel(t *testing.T) {
+	enabled, disabled := true, false
+
+	if got := policyEnabledAPIToModel(types.BoolNull(), &enabled); !got.IsNull() {
+		t.Errorf("Expected null for unset default, got %s", got)
+	}
+	if got := policyEnabledAPIToModel(types.BoolNull(), nil); !got.IsNull() {
+		t.Errorf("Expected null for omitted default, got %s", got)
+	}
+	if got := policyEnabledAPIToModel(types.BoolNull(), &disabled); !got.Equal(types.BoolValue(false)) {
+		t.Errorf("Expected false when disabled outside of Terraform, got %s", got)
+	}
+	if got := policyEnabledAPIToModel(types.BoolValue(true), &enabled); !got.Equal(types.BoolValue(true)) {
+		t.Errorf("Expected explicit true to be kept, got %s", got)
+	}
+}
@@ -0,0 +1,372 @@
+// Copyright (c) Trifork
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-corax/internal/coraxclient"
+	api "terraform-provider-corax/internal/generated"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &EvaluationDatasetResource{}
+var _ resource.ResourceWithImportState = &EvaluationDatasetResource{}
+
+func NewEvaluationDatasetResource() resource.Resource {
+	return &EvaluationDatasetResource{}
+}
+
+// EvaluationDatasetResource defines the resource implementation.
+type EvaluationDatasetResource struct {
+	client *coraxclient.Client
+}
+
+// EvaluationDatasetResourceModel describes the resource data model.
+// Based on components.schemas.EvaluationDatasetRepresentation.
+type EvaluationDatasetResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	ProjectID     types.String `tfsdk:"project_id"` // Nullable
+	IsPublic      types.Bool   `tfsdk:"is_public"`
+	Configuration types.Object `tfsdk:"configuration"` // EvaluationDatasetConfigurationModel
+	ItemsCount    types.Int64  `tfsdk:"items_count"`
+	UpdatedAt     types.String `tfsdk:"updated_at"`
+}
+
+// EvaluationDatasetConfigurationModel maps to components.schemas.EvaluationDatasetConfigurationBase.
+type EvaluationDatasetConfigurationModel struct {
+	Description     types.String `tfsdk:"description"`       // Nullable
+	InputVariables  types.List   `tfsdk:"input_variables"`   // List of strings
+	OutputType      types.String `tfsdk:"output_type"`       // "text" or "json"
+	OutputSchemaDef types.String `tfsdk:"output_schema_def"` // JSON string, nullable
+}
+
+func evaluationDatasetConfigurationAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"description":       types.StringType,
+		"input_variables":   types.ListType{ElemType: types.StringType},
+		"output_type":       types.StringType,
+		"output_schema_def": types.StringType,
+	}
+}
+
+// evaluationDatasetConfigurationModelToAPI converts the configuration object to the API struct.
+func evaluationDatasetConfigurationModelToAPI(ctx context.Context, config types.Object, diags *diag.Diagnostics) api.EvaluationDatasetConfigurationBase {
+	var cfgModel EvaluationDatasetConfigurationModel
+	diags.Append(config.As(ctx, &cfgModel, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return api.EvaluationDatasetConfigurationBase{}
+	}
+
+	apiConfig := api.NewEvaluationDatasetConfigurationBase(api.EvaluationDatasetOutputType(cfgModel.OutputType.ValueString()))
+	if !cfgModel.Description.IsNull() && !cfgModel.Description.IsUnknown() {
+		apiConfig.SetDescription(cfgModel.Description.ValueString())
+	}
+	if !cfgModel.InputVariables.IsNull() && !cfgModel.InputVariables.IsUnknown() {
+		var inputVariables []string
+		diags.Append(cfgModel.InputVariables.ElementsAs(ctx, &inputVariables, false)...)
+		apiConfig.SetInputVariables(inputVariables)
+	}
+	if schemaDef := schemaDefToAPI(ctx, cfgModel.OutputSchemaDef, diags); schemaDef != nil {
+		apiConfig.SetOutputSchemaDef(schemaDef)
+	}
+
+	return *apiConfig
+}
+
+// evaluationDatasetConfigurationAPIToModel converts the API configuration to a types.Object.
+// The current value is used to keep an unset input_variables list null when the API returns an empty list.
+func evaluationDatasetConfigurationAPIToModel(ctx context.Context, apiConfig api.EvaluationDatasetConfigurationBase, current types.Object, diags *diag.Diagnostics) types.Object {
+	var currentModel EvaluationDatasetConfigurationModel
+	if !current.IsNull() && !current.IsUnknown() {
+		diags.Append(current.As(ctx, &currentModel, basetypes.ObjectAsOptions{})...)
+	}
+
+	attrs := map[string]attr.Value{
+		"output_type":       types.StringValue(string(apiConfig.GetOutputType())),
+		"output_schema_def": schemaDefAPIToString(apiConfig.GetOutputSchemaDef(), diags),
+	}
+
+	if description, ok := apiConfig.GetDescriptionOk(); ok && description != nil && *description != "" {
+		attrs["description"] = types.StringValue(*description)
+	} else {
+		attrs["description"] = types.StringNull()
+	}
+
+	inputVariables := apiConfig.GetInputVariables()
+	if len(inputVariables) == 0 && (currentModel.InputVariables.IsNull() || currentModel.InputVariables.IsUnknown()) {
+		attrs["input_variables"] = types.ListNull(types.StringType)
+	} else {
+		if inputVariables == nil {
+			inputVariables = []string{}
+		}
+		listVal, listDiags := types.ListValueFrom(ctx, types.StringType, inputVariables)
+		diags.Append(listDiags...)
+		attrs["input_variables"] = listVal
+	}
+
+	objVal, objDiags := types.ObjectValue(evaluationDatasetConfigurationAttributeTypes(), attrs)
+	diags.Append(objDiags...)
+	return objVal
+}
+
+// mapEvaluationDatasetToModel maps an api.EvaluationDatasetRepresentation to the Terraform model.
+func mapEvaluationDatasetToModel(ctx context.Context, dataset *api.EvaluationDatasetRepresentation, model *EvaluationDatasetResourceModel, diags *diag.Diagnostics) {
+	model.ID = types.StringValue(dataset.Id)
+	model.Name = types.StringValue(dataset.Name)
+	if projectID, ok := dataset.GetProjectIdOk(); ok && projectID != nil && *projectID != "" {
+		model.ProjectID = types.StringValue(*projectID)
+	} else {
+		model.ProjectID = types.StringNull()
+	}
+	model.IsPublic = types.BoolValue(dataset.GetIsPublic())
+	model.Configuration = evaluationDatasetConfigurationAPIToModel(ctx, dataset.Configuration, model.Configuration, diags)
+	model.ItemsCount = types.Int64Value(int64(dataset.GetItemsCount()))
+	if updatedAt, ok := dataset.GetUpdatedAtOk(); ok && updatedAt != nil {
+		model.UpdatedAt = types.StringValue(updatedAt.Format(time.RFC3339))
+	} else {
+		model.UpdatedAt = types.StringNull()
+	}
+}
+
+func (r *EvaluationDatasetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_evaluation_dataset"
+}
+
+func (r *EvaluationDatasetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Corax Evaluation Dataset. Datasets hold the input/expected output pairs that capabilities are evaluated against.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier for the evaluation dataset (UUID).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the evaluation dataset.",
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"project_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The UUID of the project this dataset belongs to.",
+				Validators:          []validator.String{uuidValidator()},
+			},
+			"is_public": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true, // API defaults to false if not provided
+				MarkdownDescription: "Indicates whether the dataset is public. Defaults to false.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"configuration": schema.SingleNestedAttribute{
+				Required:            true,
+				MarkdownDescription: "Describes the shape of the items in the dataset.",
+				Attributes: map[string]schema.Attribute{
+					"description": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "An optional description for the dataset.",
+					},
+					"input_variables": schema.ListAttribute{
+						ElementType:         types.StringType,
+						Optional:            true,
+						MarkdownDescription: "The names of the input variables each item provides.",
+					},
+					"output_type": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "The type of the expected output. Must be `text` or `json`.",
+						Validators: []validator.String{
+							stringvalidator.OneOf(string(api.EVALUATION_DATASET_OUTPUT_TYPE_TEXT), string(api.EVALUATION_DATASET_OUTPUT_TYPE_JSON)),
+						},
+					},
+					"output_schema_def": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "A JSON schema (as a JSON string) describing the expected output when `output_type` is `json`.",
+					},
+				},
+			},
+			"items_count": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The number of items in the dataset.",
+			},
+			"updated_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The date and time the dataset was last updated (RFC3339 format).",
+			},
+		},
+	}
+}
+
+func (r *EvaluationDatasetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*coraxclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *coraxclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *EvaluationDatasetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data EvaluationDatasetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating Evaluation Dataset with name: %s", data.Name.ValueString()))
+
+	config := evaluationDatasetConfigurationModelToAPI(ctx, data.Configuration, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createPayload := api.NewEvaluationDatasetCreate(data.Name.ValueString(), config)
+	if !data.ProjectID.IsNull() && !data.ProjectID.IsUnknown() {
+		createPayload.SetProjectId(data.ProjectID.ValueString())
+	}
+	if !data.IsPublic.IsNull() && !data.IsPublic.IsUnknown() {
+		createPayload.SetIsPublic(data.IsPublic.ValueBool())
+	}
+
+	createdDataset, err := r.client.CreateEvaluationDataset(ctx, *createPayload)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create evaluation dataset, got error: %s", err))
+		return
+	}
+
+	mapEvaluationDatasetToModel(ctx, createdDataset, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("Evaluation Dataset created successfully with ID: %s", createdDataset.Id))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *EvaluationDatasetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data EvaluationDatasetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	datasetID := data.ID.ValueString()
+	tflog.Debug(ctx, fmt.Sprintf("Reading Evaluation Dataset with ID: %s", datasetID))
+
+	dataset, err := r.client.GetEvaluationDataset(ctx, datasetID)
+	if err != nil {
+		if errors.Is(err, coraxclient.ErrNotFound) {
+			tflog.Warn(ctx, fmt.Sprintf("Evaluation Dataset with ID %s not found, removing from state", datasetID))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read evaluation dataset %s, got error: %s", datasetID, err))
+		return
+	}
+
+	mapEvaluationDatasetToModel(ctx, dataset, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Successfully read Evaluation Dataset with ID: %s", datasetID))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *EvaluationDatasetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan EvaluationDatasetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state EvaluationDatasetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	datasetID := state.ID.ValueString() // ID comes from state, not plan
+	tflog.Debug(ctx, fmt.Sprintf("Updating Evaluation Dataset with ID: %s", datasetID))
+
+	config := evaluationDatasetConfigurationModelToAPI(ctx, plan.Configuration, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updatePayload := api.NewEvaluationDatasetUpdate(plan.Name.ValueString(), config, datasetID)
+	if !plan.ProjectID.IsNull() && !plan.ProjectID.IsUnknown() {
+		updatePayload.SetProjectId(plan.ProjectID.ValueString())
+	} else {
+		updatePayload.SetProjectIdNil()
+	}
+	if !plan.IsPublic.IsNull() && !plan.IsPublic.IsUnknown() {
+		updatePayload.SetIsPublic(plan.IsPublic.ValueBool())
+	}
+
+	updatedDataset, err := r.client.UpdateEvaluationDataset(ctx, datasetID, *updatePayload)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update evaluation dataset %s, got error: %s", datasetID, err))
+		return
+	}
+
+	mapEvaluationDatasetToModel(ctx, updatedDataset, &plan, &resp.Diagnostics) // Update plan with response
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("Evaluation Dataset updated successfully with ID: %s", datasetID))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *EvaluationDatasetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data EvaluationDatasetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	datasetID := data.ID.ValueString()
+	tflog.Debug(ctx, fmt.Sprintf("Deleting Evaluation Dataset with ID: %s", datasetID))
+
+	err := r.client.DeleteEvaluationDataset(ctx, datasetID)
+	if err != nil {
+		if errors.Is(err, coraxclient.ErrNotFound) {
+			tflog.Warn(ctx, fmt.Sprintf("Evaluation Dataset with ID %s already deleted, removing from state", datasetID))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete evaluation dataset %s, got error: %s", datasetID, err))
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Evaluation Dataset with ID %s deleted successfully", datasetID))
+}
+
+func (r *EvaluationDatasetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
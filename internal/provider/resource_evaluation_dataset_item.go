@@ -0,0 +1,349 @@
+// Copyright (c) Trifork
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-corax/internal/coraxclient"
+	api "terraform-provider-corax/internal/generated"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &EvaluationDatasetItemResource{}
+var _ resource.ResourceWithImportState = &EvaluationDatasetItemResource{}
+
+func NewEvaluationDatasetItemResource() resource.Resource {
+	return &EvaluationDatasetItemResource{}
+}
+
+// EvaluationDatasetItemResource defines the resource implementation.
+type EvaluationDatasetItemResource struct {
+	client *coraxclient.Client
+}
+
+// EvaluationDatasetItemResourceModel describes the resource data model.
+// Based on components.schemas.EvaluationDatasetItemRepresentation.
+type EvaluationDatasetItemResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	DatasetID types.String `tfsdk:"dataset_id"`
+	Input     types.Map    `tfsdk:"input"`   // Map of input variable name to value
+	Output    types.String `tfsdk:"output"`  // JSON object string
+	Sources   types.List   `tfsdk:"sources"` // Nullable list of strings
+	CreatedAt types.String `tfsdk:"created_at"`
+}
+
+// jsonObjectToAPI parses a JSON object string into a map for the API.
+func jsonObjectToAPI(value types.String, attributeName string, diags *diag.Diagnostics) map[string]interface{} {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+
+	var goMap map[string]interface{}
+	if err := json.Unmarshal([]byte(value.ValueString()), &goMap); err != nil {
+		diags.AddAttributeError(path.Root(attributeName), "Invalid JSON Object",
+			fmt.Sprintf("Failed to parse %s as a JSON object: %s", attributeName, err))
+		return nil
+	}
+
+	return goMap
+}
+
+// jsonObjectAPIToString converts an API map to a JSON string. The current value is kept
+// when it is semantically equal to the API value so that formatting differences don't cause a diff.
+func jsonObjectAPIToString(current types.String, apiValue map[string]interface{}, diags *diag.Diagnostics) types.String {
+	if !current.IsNull() && !current.IsUnknown() {
+		var currentMap map[string]interface{}
+		if err := json.Unmarshal([]byte(current.ValueString()), &currentMap); err == nil && reflect.DeepEqual(currentMap, normalizeJSONObject(apiValue)) {
+			return current
+		}
+	}
+
+	if apiValue == nil {
+		apiValue = map[string]interface{}{}
+	}
+	jsonBytes, err := json.Marshal(apiValue)
+	if err != nil {
+		diags.AddError("JSON Conversion Error", fmt.Sprintf("Failed to marshal API value to JSON: %s", err))
+		return types.StringNull()
+	}
+
+	return types.StringValue(string(jsonBytes))
+}
+
+// normalizeJSONObject round-trips a map through JSON so that it can be compared with a decoded JSON string.
+func normalizeJSONObject(value map[string]interface{}) map[string]interface{} {
+	jsonBytes, err := json.Marshal(value)
+	if err != nil {
+		return value
+	}
+	var normalized map[string]interface{}
+	if err := json.Unmarshal(jsonBytes, &normalized); err != nil {
+		return value
+	}
+	return normalized
+}
+
+// mapEvaluationDatasetItemToModel maps an api.EvaluationDatasetItemRepresentation to the Terraform model.
+func mapEvaluationDatasetItemToModel(ctx context.Context, item *api.EvaluationDatasetItemRepresentation, model *EvaluationDatasetItemResourceModel, diags *diag.Diagnostics) {
+	model.ID = types.StringValue(item.Id)
+
+	inputVal, inputDiags := types.MapValueFrom(ctx, types.StringType, item.Input)
+	diags.Append(inputDiags...)
+	model.Input = inputVal
+
+	model.Output = jsonObjectAPIToString(model.Output, item.Output, diags)
+
+	sources := item.GetSources()
+	if len(sources) == 0 && (model.Sources.IsNull() || model.Sources.IsUnknown()) {
+		model.Sources = types.ListNull(types.StringType)
+	} else {
+		if sources == nil {
+			sources = []string{}
+		}
+		listVal, listDiags := types.ListValueFrom(ctx, types.StringType, sources)
+		diags.Append(listDiags...)
+		model.Sources = listVal
+	}
+
+	if createdAt, ok := item.GetCreatedAtOk(); ok && createdAt != nil {
+		model.CreatedAt = types.StringValue(createdAt.Format(time.RFC3339))
+	} else {
+		model.CreatedAt = types.StringNull()
+	}
+}
+
+func (r *EvaluationDatasetItemResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_evaluation_dataset_item"
+}
+
+func (r *EvaluationDatasetItemResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a single item of a Corax Evaluation Dataset.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier for the dataset item (UUID).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dataset_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The UUID of the evaluation dataset this item belongs to. Changing this forces a new item to be created.",
+				Validators:          []validator.String{uuidValidator()},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"input": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Required:            true,
+				MarkdownDescription: "The input variables for the item, keyed by variable name.",
+			},
+			"output": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The expected output as a JSON object string, e.g. `jsonencode({ answer = \"42\" })`.",
+			},
+			"sources": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Optional sources that support the expected output.",
+			},
+			"created_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The date and time the item was created (RFC3339 format).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *EvaluationDatasetItemResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*coraxclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *coraxclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *EvaluationDatasetItemResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data EvaluationDatasetItemResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	datasetID := data.DatasetID.ValueString()
+	tflog.Debug(ctx, fmt.Sprintf("Creating Evaluation Dataset Item in dataset: %s", datasetID))
+
+	var input map[string]string
+	resp.Diagnostics.Append(data.Input.ElementsAs(ctx, &input, false)...)
+	output := jsonObjectToAPI(data.Output, "output", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createPayload := api.NewEvaluationDatasetItemCreate(input, output)
+	if !data.Sources.IsNull() && !data.Sources.IsUnknown() {
+		var sources []string
+		resp.Diagnostics.Append(data.Sources.ElementsAs(ctx, &sources, false)...)
+		createPayload.SetSources(sources)
+	}
+
+	createdItems, err := r.client.AddEvaluationDatasetItems(ctx, datasetID, []api.EvaluationDatasetItemCreate{*createPayload})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create evaluation dataset item in dataset %s, got error: %s", datasetID, err))
+		return
+	}
+	if len(createdItems) != 1 {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Expected 1 created evaluation dataset item, got %d", len(createdItems)))
+		return
+	}
+
+	mapEvaluationDatasetItemToModel(ctx, &createdItems[0], &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("Evaluation Dataset Item created successfully with ID: %s", data.ID.ValueString()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *EvaluationDatasetItemResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data EvaluationDatasetItemResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	datasetID := data.DatasetID.ValueString()
+	itemID := data.ID.ValueString()
+	tflog.Debug(ctx, fmt.Sprintf("Reading Evaluation Dataset Item %s in dataset: %s", itemID, datasetID))
+
+	item, err := r.client.GetEvaluationDatasetItem(ctx, datasetID, itemID)
+	if err != nil {
+		if errors.Is(err, coraxclient.ErrNotFound) {
+			tflog.Warn(ctx, fmt.Sprintf("Evaluation Dataset Item %s in dataset %s not found, removing from state", itemID, datasetID))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read evaluation dataset item %s, got error: %s", itemID, err))
+		return
+	}
+
+	mapEvaluationDatasetItemToModel(ctx, item, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Successfully read Evaluation Dataset Item with ID: %s", itemID))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *EvaluationDatasetItemResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan EvaluationDatasetItemResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state EvaluationDatasetItemResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	datasetID := state.DatasetID.ValueString()
+	itemID := state.ID.ValueString() // ID comes from state, not plan
+	tflog.Debug(ctx, fmt.Sprintf("Updating Evaluation Dataset Item %s in dataset: %s", itemID, datasetID))
+
+	var input map[string]string
+	resp.Diagnostics.Append(plan.Input.ElementsAs(ctx, &input, false)...)
+	output := jsonObjectToAPI(plan.Output, "output", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updatePayload := api.NewEvaluationDatasetItemUpdate(input, output, itemID)
+	sources := []string{}
+	if !plan.Sources.IsNull() && !plan.Sources.IsUnknown() {
+		resp.Diagnostics.Append(plan.Sources.ElementsAs(ctx, &sources, false)...)
+	}
+	updatePayload.SetSources(sources)
+
+	updatedItem, err := r.client.UpdateEvaluationDatasetItem(ctx, datasetID, itemID, *updatePayload)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update evaluation dataset item %s, got error: %s", itemID, err))
+		return
+	}
+
+	mapEvaluationDatasetItemToModel(ctx, updatedItem, &plan, &resp.Diagnostics) // Update plan with response
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("Evaluation Dataset Item updated successfully with ID: %s", itemID))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *EvaluationDatasetItemResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data EvaluationDatasetItemResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	datasetID := data.DatasetID.ValueString()
+	itemID := data.ID.ValueString()
+	tflog.Debug(ctx, fmt.Sprintf("Deleting Evaluation Dataset Item %s in dataset: %s", itemID, datasetID))
+
+	err := r.client.DeleteEvaluationDatasetItem(ctx, datasetID, itemID)
+	if err != nil {
+		if errors.Is(err, coraxclient.ErrNotFound) {
+			tflog.Warn(ctx, fmt.Sprintf("Evaluation Dataset Item %s already deleted, removing from state", itemID))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete evaluation dataset item %s, got error: %s", itemID, err))
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Evaluation Dataset Item with ID %s deleted successfully", itemID))
+}
+
+func (r *EvaluationDatasetItemResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: dataset_id/item_id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("dataset_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+}
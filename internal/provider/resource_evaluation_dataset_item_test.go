@@ -0,0 +1,134 @@
+// Copyright (c) Trifork
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccEvaluationDatasetItemResource_basic(t *testing.T) {
+	if os.Getenv("CORAX_API_ENDPOINT") == "" || os.Getenv("CORAX_API_KEY") == "" {
+		t.Skip("Skipping acceptance test: CORAX_API_ENDPOINT or CORAX_API_KEY not set")
+	}
+
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	datasetName := fmt.Sprintf("tf-acc-test-dataset-item-%s", rName)
+	resourceName := "corax_evaluation_dataset_item.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccEvaluationDatasetItemResourceConfig(datasetName, "What is 6 x 7?", "42"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "dataset_id", "corax_evaluation_dataset.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "input.question", "What is 6 x 7?"),
+					resource.TestCheckResourceAttr(resourceName, "output", `{"answer":"42"}`),
+					resource.TestCheckResourceAttr(resourceName, "sources.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName: resourceName,
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources[resourceName]
+					return fmt.Sprintf("%s/%s", rs.Primary.Attributes["dataset_id"], rs.Primary.ID), nil
+				},
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccEvaluationDatasetItemResourceConfig(datasetName, "What is 6 x 9?", "54"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "input.question", "What is 6 x 9?"),
+					resource.TestCheckResourceAttr(resourceName, "output", `{"answer":"54"}`),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccEvaluationDatasetItemResourceConfig(datasetName, question, answer string) string {
+	return fmt.Sprintf(`
+provider "corax" {}
+
+resource "corax_evaluation_dataset" "test" {
+  name = %[1]q
+
+  configuration = {
+    input_variables = ["question"]
+    output_type     = "json"
+  }
+}
+
+resource "corax_evaluation_dataset_item" "test" {
+  dataset_id = corax_evaluation_dataset.test.id
+  input = {
+    question = %[2]q
+  }
+  output  = jsonencode({ answer = %[3]q })
+  sources = ["arithmetic"]
+}
+`, datasetName, question, answer)
+}
+
+func TestJSONObjectAPIToString(t *testing.T) {
+	tests := []struct {
+		name     string
+		current  types.String
+		apiValue map[string]interface{}
+		expected types.String
+	}{
+		{
+			name:     "null current is marshalled",
+			current:  types.StringNull(),
+			apiValue: map[string]interface{}{"b": 1, "a": "x"},
+			expected: types.StringValue(`{"a":"x","b":1}`),
+		},
+		{
+			name:     "semantically equal current is kept",
+			current:  types.StringValue("{\n  \"b\": 1,\n  \"a\": \"x\"\n}"),
+			apiValue: map[string]interface{}{"a": "x", "b": 1},
+			expected: types.StringValue("{\n  \"b\": 1,\n  \"a\": \"x\"\n}"),
+		},
+		{
+			name:     "changed value replaces current",
+			current:  types.StringValue(`{"a":"x"}`),
+			apiValue: map[string]interface{}{"a": "y"},
+			expected: types.StringValue(`{"a":"y"}`),
+		},
+		{
+			name:     "nil API value becomes empty object",
+			current:  types.StringNull(),
+			apiValue: nil,
+			expected: types.StringValue(`{}`),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			result := jsonObjectAPIToString(tt.current, tt.apiValue, &diags)
+
+			if diags.HasError() {
+				t.Fatalf("Unexpected error: %v", diags)
+			}
+			if !result.Equal(tt.expected) {
+				t.Errorf("Expected %s, got %s", tt.expected, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,762 @@
+// Copyright (c) Trifork
+
+package provider
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-corax/internal/coraxclient"
+	api "terraform-provider-corax/internal/generated"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &EvaluationDatasetItemsResource{}
+var _ resource.ResourceWithModifyPlan = &EvaluationDatasetItemsResource{}
+
+func NewEvaluationDatasetItemsResource() resource.Resource {
+	return &EvaluationDatasetItemsResource{}
+}
+
+// EvaluationDatasetItemsResource manages the items of an evaluation dataset from a JSONL or CSV file.
+type EvaluationDatasetItemsResource struct {
+	client *coraxclient.Client
+}
+
+// EvaluationDatasetItemsResourceModel describes the resource data model.
+type EvaluationDatasetItemsResourceModel struct {
+	ID            types.String `tfsdk:"id"` // Same as dataset_id
+	DatasetID     types.String `tfsdk:"dataset_id"`
+	SourcePath    types.String `tfsdk:"source_path"`
+	Format        types.String `tfsdk:"format"` // "jsonl" or "csv", inferred from the file extension
+	KeyColumn     types.String `tfsdk:"key_column"`
+	InputColumns  types.List   `tfsdk:"input_columns"`
+	OutputColumn  types.String `tfsdk:"output_column"`
+	SourcesColumn types.String `tfsdk:"sources_column"`
+	Items         types.Map    `tfsdk:"items"` // Row key -> EvaluationDatasetItemsEntryModel
+}
+
+// EvaluationDatasetItemsEntryModel tracks the dataset item created for a single row.
+type EvaluationDatasetItemsEntryModel struct {
+	ItemID      types.String `tfsdk:"item_id"`
+	ContentHash types.String `tfsdk:"content_hash"`
+}
+
+func evaluationDatasetItemsEntryAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"item_id":      types.StringType,
+		"content_hash": types.StringType,
+	}
+}
+
+// evaluationDatasetRow is a single row of the source file mapped to the API item shape.
+type evaluationDatasetRow struct {
+	Key     string
+	Input   map[string]string
+	Output  map[string]interface{}
+	Sources []string
+}
+
+// evaluationDatasetRowOptions controls how columns of the source file are mapped to items.
+type evaluationDatasetRowOptions struct {
+	Format        string
+	KeyColumn     string
+	InputColumns  []string // nil means every column that isn't the key, output or sources column
+	OutputColumn  string
+	SourcesColumn string
+}
+
+// evaluationDatasetItemsFormat returns the configured format, or infers it from the file extension.
+func evaluationDatasetItemsFormat(sourcePath string, format types.String) (string, error) {
+	if !format.IsNull() && !format.IsUnknown() {
+		return format.ValueString(), nil
+	}
+
+	switch strings.ToLower(filepath.Ext(sourcePath)) {
+	case ".jsonl", ".ndjson":
+		return "jsonl", nil
+	case ".csv":
+		return "csv", nil
+	}
+	return "", fmt.Errorf("unable to infer the format of %s from its extension, set format to \"jsonl\" or \"csv\"", sourcePath)
+}
+
+// evaluationDatasetRowOptionsFromModel builds the row options from the resource model.
+func evaluationDatasetRowOptionsFromModel(ctx context.Context, model EvaluationDatasetItemsResourceModel, diags *diag.Diagnostics) evaluationDatasetRowOptions {
+	opts := evaluationDatasetRowOptions{
+		Format:        model.Format.ValueString(),
+		KeyColumn:     model.KeyColumn.ValueString(),
+		OutputColumn:  model.OutputColumn.ValueString(),
+		SourcesColumn: model.SourcesColumn.ValueString(),
+	}
+	if !model.InputColumns.IsNull() && !model.InputColumns.IsUnknown() {
+		opts.InputColumns = []string{}
+		diags.Append(model.InputColumns.ElementsAs(ctx, &opts.InputColumns, false)...)
+	}
+	return opts
+}
+
+// readEvaluationDatasetRows reads the source file and maps each record to an evaluationDatasetRow.
+func readEvaluationDatasetRows(sourcePath string, opts evaluationDatasetRowOptions) ([]evaluationDatasetRow, error) {
+	content, err := os.ReadFile(sourcePath)
+	if err != nil {
+		return nil, err
+	}
+
+	var records []map[string]interface{}
+	switch opts.Format {
+	case "jsonl":
+		records, err = parseJSONLRecords(content)
+	case "csv":
+		records, err = parseCSVRecords(content)
+	default:
+		err = fmt.Errorf("unsupported format %q", opts.Format)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]evaluationDatasetRow, 0, len(records))
+	seenKeys := make(map[string]int, len(records))
+	for i, record := range records {
+		rowNumber := i + 1
+		row, err := evaluationDatasetRowFromRecord(record, rowNumber, opts)
+		if err != nil {
+			return nil, fmt.Errorf("row %d: %w", rowNumber, err)
+		}
+		if previous, ok := seenKeys[row.Key]; ok {
+			return nil, fmt.Errorf("row %d: duplicate key %q, already used by row %d", rowNumber, row.Key, previous)
+		}
+		seenKeys[row.Key] = rowNumber
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+// parseJSONLRecords parses one JSON object per non-blank line.
+func parseJSONLRecords(content []byte) ([]map[string]interface{}, error) {
+	var records []map[string]interface{}
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		var record map[string]interface{}
+		if err := json.Unmarshal([]byte(line), &record); err != nil {
+			return nil, fmt.Errorf("line %d: expected a JSON object: %w", lineNumber, err)
+		}
+		records = append(records, record)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+// parseCSVRecords parses a CSV file with a header row.
+func parseCSVRecords(content []byte) ([]map[string]interface{}, error) {
+	reader := csv.NewReader(bytes.NewReader(content))
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("reading header: %w", err)
+	}
+
+	var records []map[string]interface{}
+	for {
+		fields, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		record := make(map[string]interface{}, len(header))
+		for i, column := range header {
+			record[column] = fields[i]
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// evaluationDatasetRowFromRecord maps the columns of a single record to an item.
+func evaluationDatasetRowFromRecord(record map[string]interface{}, rowNumber int, opts evaluationDatasetRowOptions) (evaluationDatasetRow, error) {
+	row := evaluationDatasetRow{Key: strconv.Itoa(rowNumber)}
+
+	if opts.KeyColumn != "" {
+		value, ok := record[opts.KeyColumn]
+		if !ok || stringifyRecordValue(value) == "" {
+			return row, fmt.Errorf("missing value for key column %q", opts.KeyColumn)
+		}
+		row.Key = stringifyRecordValue(value)
+	}
+
+	outputValue, ok := record[opts.OutputColumn]
+	if !ok {
+		return row, fmt.Errorf("missing output column %q", opts.OutputColumn)
+	}
+	switch v := outputValue.(type) {
+	case map[string]interface{}:
+		row.Output = v
+	case string:
+		var parsed map[string]interface{}
+		if err := json.Unmarshal([]byte(v), &parsed); err == nil && parsed != nil {
+			row.Output = parsed
+		} else {
+			row.Output = map[string]interface{}{opts.OutputColumn: v}
+		}
+	default:
+		row.Output = map[string]interface{}{opts.OutputColumn: v}
+	}
+
+	if sourcesValue, ok := record[opts.SourcesColumn]; ok && sourcesValue != nil {
+		sources, err := recordSources(sourcesValue)
+		if err != nil {
+			return row, fmt.Errorf("sources column %q: %w", opts.SourcesColumn, err)
+		}
+		row.Sources = sources
+	}
+
+	row.Input = make(map[string]string)
+	if opts.InputColumns != nil {
+		for _, column := range opts.InputColumns {
+			value, ok := record[column]
+			if !ok {
+				return row, fmt.Errorf("missing input column %q", column)
+			}
+			row.Input[column] = stringifyRecordValue(value)
+		}
+	} else {
+		for column, value := range record {
+			if column == opts.KeyColumn || column == opts.OutputColumn || column == opts.SourcesColumn {
+				continue
+			}
+			row.Input[column] = stringifyRecordValue(value)
+		}
+	}
+
+	return row, nil
+}
+
+// recordSources converts a sources value to a list. Strings holding a JSON array are decoded,
+// other non-empty strings are treated as a single source.
+func recordSources(value interface{}) ([]string, error) {
+	switch v := value.(type) {
+	case []interface{}:
+		sources := make([]string, 0, len(v))
+		for _, source := range v {
+			sources = append(sources, stringifyRecordValue(source))
+		}
+		return sources, nil
+	case string:
+		trimmed := strings.TrimSpace(v)
+		if trimmed == "" {
+			return nil, nil
+		}
+		if strings.HasPrefix(trimmed, "[") {
+			var sources []string
+			if err := json.Unmarshal([]byte(trimmed), &sources); err != nil {
+				return nil, fmt.Errorf("expected a JSON array of strings: %w", err)
+			}
+			return sources, nil
+		}
+		return []string{v}, nil
+	}
+	return nil, fmt.Errorf("expected a string or an array, got %T", value)
+}
+
+// stringifyRecordValue returns strings as-is and JSON encodes any other value.
+func stringifyRecordValue(value interface{}) string {
+	if s, ok := value.(string); ok {
+		return s
+	}
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(encoded)
+}
+
+// hashEvaluationDatasetItem returns the hex encoded SHA-256 digest of an item's content.
+// Map keys are sorted by encoding/json, so the digest doesn't depend on column order.
+func hashEvaluationDatasetItem(input map[string]string, output map[string]interface{}, sources []string) string {
+	if input == nil {
+		input = map[string]string{}
+	}
+	if sources == nil {
+		sources = []string{}
+	}
+	content, _ := json.Marshal(struct {
+		Input   map[string]string      `json:"input"`
+		Output  map[string]interface{} `json:"output"`
+		Sources []string               `json:"sources"`
+	}{input, normalizeJSONObject(output), sources})
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+func (r *EvaluationDatasetItemsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_evaluation_dataset_items"
+}
+
+func (r *EvaluationDatasetItemsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the items of an existing Corax Evaluation Dataset from a local JSONL or CSV file. " +
+			"Each row becomes one dataset item. Rows are identified by `key_column` (or their row number) and tracked by a content hash, " +
+			"so the plan shows exactly which rows are added, changed or removed.\n\n" +
+			"JSONL files hold one JSON object per line. CSV files must have a header row. The `output_column` value becomes the item output: " +
+			"JSON objects (or CSV cells holding a JSON object) are used as-is, other values are wrapped as `{\"<output_column>\": value}`. " +
+			"The `sources_column` value may be an array, a JSON array string or a single source.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the evaluation dataset. Same as `dataset_id`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dataset_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The UUID of the evaluation dataset to load the items into. Changing this forces a new resource to be created.",
+				Validators:          []validator.String{uuidValidator()},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_path": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Path to the local JSONL or CSV file holding the items.",
+			},
+			"format": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The format of the source file, `jsonl` or `csv`. Inferred from the file extension (`.jsonl`, `.ndjson`, `.csv`) if not set.",
+				Validators:          []validator.String{stringvalidator.OneOf("jsonl", "csv")},
+			},
+			"key_column": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "Column holding a unique, stable key for each row. If not set, rows are keyed by their row number, " +
+					"so inserting or removing a row also changes every row after it.",
+			},
+			"input_columns": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Columns to use as the item input. Defaults to every column other than the key, output and sources columns.",
+			},
+			"output_column": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("output"),
+				MarkdownDescription: "Column holding the expected output. Defaults to `output`.",
+			},
+			"sources_column": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("sources"),
+				MarkdownDescription: "Column holding the item sources. Defaults to `sources`. The column is optional in the file.",
+			},
+			"items": schema.MapNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The dataset items created from the file, keyed by row key.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"item_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the dataset item created for the row.",
+						},
+						"content_hash": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The SHA-256 hash of the row's input, output and sources.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *EvaluationDatasetItemsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*coraxclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *coraxclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+// ModifyPlan reads the source file and plans the per-row content hashes. Rows whose hash is unchanged
+// keep their item ID, so only added, changed and removed rows show up in the plan.
+func (r *EvaluationDatasetItemsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return // Resource is being destroyed
+	}
+
+	var plan EvaluationDatasetItemsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var configFormat types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("format"), &configFormat)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.SourcePath.IsUnknown() || configFormat.IsUnknown() || plan.KeyColumn.IsUnknown() ||
+		plan.InputColumns.IsUnknown() || plan.OutputColumn.IsUnknown() || plan.SourcesColumn.IsUnknown() {
+		plan.Items = types.MapUnknown(types.ObjectType{AttrTypes: evaluationDatasetItemsEntryAttributeTypes()})
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+		return
+	}
+
+	format, err := evaluationDatasetItemsFormat(plan.SourcePath.ValueString(), configFormat)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("format"), "Unknown Source Format", err.Error())
+		return
+	}
+	plan.Format = types.StringValue(format)
+
+	opts := evaluationDatasetRowOptionsFromModel(ctx, plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	rows, err := readEvaluationDatasetRows(plan.SourcePath.ValueString(), opts)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("source_path"), "Unable to Read Evaluation Dataset Items", fmt.Sprintf("Unable to read %s: %s", plan.SourcePath.ValueString(), err))
+		return
+	}
+
+	current := map[string]EvaluationDatasetItemsEntryModel{}
+	if !req.State.Raw.IsNull() {
+		var state EvaluationDatasetItemsResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !state.DatasetID.Equal(plan.DatasetID) {
+			current = map[string]EvaluationDatasetItemsEntryModel{} // Replacement creates every item again
+		} else if !state.Items.IsNull() && !state.Items.IsUnknown() {
+			resp.Diagnostics.Append(state.Items.ElementsAs(ctx, &current, false)...)
+		}
+	}
+
+	planned := make(map[string]EvaluationDatasetItemsEntryModel, len(rows))
+	for _, row := range rows {
+		hash := hashEvaluationDatasetItem(row.Input, row.Output, row.Sources)
+		entry := EvaluationDatasetItemsEntryModel{
+			ItemID:      types.StringUnknown(),
+			ContentHash: types.StringValue(hash),
+		}
+		if existing, ok := current[row.Key]; ok && existing.ContentHash.ValueString() == hash {
+			entry.ItemID = existing.ItemID
+		}
+		planned[row.Key] = entry
+	}
+
+	itemsVal, diags := types.MapValueFrom(ctx, types.ObjectType{AttrTypes: evaluationDatasetItemsEntryAttributeTypes()}, planned)
+	resp.Diagnostics.Append(diags...)
+	plan.Items = itemsVal
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// syncItems reconciles the dataset items with the rows of the source file. It returns the resulting
+// entries; when an error occurs, rows that weren't applied keep their previous entry.
+func (r *EvaluationDatasetItemsResource) syncItems(ctx context.Context, datasetID string, rows []evaluationDatasetRow, current map[string]EvaluationDatasetItemsEntryModel, diags *diag.Diagnostics) map[string]EvaluationDatasetItemsEntryModel {
+	result := make(map[string]EvaluationDatasetItemsEntryModel, len(rows))
+	rowKeys := make(map[string]struct{}, len(rows))
+	for _, row := range rows {
+		rowKeys[row.Key] = struct{}{}
+	}
+
+	// Remove the items for rows that no longer exist.
+	for key, entry := range current {
+		if _, ok := rowKeys[key]; ok {
+			continue
+		}
+		itemID := entry.ItemID.ValueString()
+		tflog.Debug(ctx, fmt.Sprintf("Deleting Evaluation Dataset Item %s for removed row %q", itemID, key))
+		if err := r.client.DeleteEvaluationDatasetItem(ctx, datasetID, itemID); err != nil && !errors.Is(err, coraxclient.ErrNotFound) {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to delete evaluation dataset item %s for row %q, got error: %s", itemID, key, err))
+			// Keep the entries that weren't touched so the next apply retries them.
+			for k, e := range current {
+				if _, ok := result[k]; !ok {
+					result[k] = e
+				}
+			}
+			return result
+		}
+	}
+
+	// Update changed rows and collect new ones, preserving the file order.
+	var newRows []evaluationDatasetRow
+	var newItems []api.EvaluationDatasetItemCreate
+	for _, row := range rows {
+		hash := hashEvaluationDatasetItem(row.Input, row.Output, row.Sources)
+		existing, ok := current[row.Key]
+		if ok && existing.ContentHash.ValueString() == hash {
+			result[row.Key] = existing
+			continue
+		}
+
+		if ok {
+			itemID := existing.ItemID.ValueString()
+			tflog.Debug(ctx, fmt.Sprintf("Updating Evaluation Dataset Item %s for changed row %q", itemID, row.Key))
+			update := api.NewEvaluationDatasetItemUpdate(row.Input, row.Output, itemID)
+			update.SetSources(row.Sources)
+			if row.Sources == nil {
+				update.SetSources([]string{})
+			}
+			_, err := r.client.UpdateEvaluationDatasetItem(ctx, datasetID, itemID, *update)
+			if err == nil {
+				result[row.Key] = EvaluationDatasetItemsEntryModel{ItemID: existing.ItemID, ContentHash: types.StringValue(hash)}
+				continue
+			}
+			if !errors.Is(err, coraxclient.ErrNotFound) {
+				diags.AddError("Client Error", fmt.Sprintf("Unable to update evaluation dataset item %s for row %q, got error: %s", itemID, row.Key, err))
+				// Keep the items of the rows that weren't processed yet so they stay tracked
+				// and the next apply retries them.
+				for k, e := range current {
+					if _, ok := rowKeys[k]; !ok {
+						continue
+					}
+					if _, ok := result[k]; !ok {
+						result[k] = e
+					}
+				}
+				return result
+			}
+			tflog.Warn(ctx, fmt.Sprintf("Evaluation Dataset Item %s for row %q not found, creating it again", itemID, row.Key))
+		}
+
+		item := api.NewEvaluationDatasetItemCreate(row.Input, row.Output)
+		if row.Sources != nil {
+			item.SetSources(row.Sources)
+		}
+		newRows = append(newRows, row)
+		newItems = append(newItems, *item)
+	}
+
+	if len(newItems) > 0 {
+		tflog.Debug(ctx, fmt.Sprintf("Adding %d Evaluation Dataset Items to dataset %s", len(newItems), datasetID))
+		created, err := r.client.AddEvaluationDatasetItems(ctx, datasetID, newItems)
+		for i := range created {
+			row := newRows[i]
+			result[row.Key] = EvaluationDatasetItemsEntryModel{
+				ItemID:      types.StringValue(created[i].Id),
+				ContentHash: types.StringValue(hashEvaluationDatasetItem(row.Input, row.Output, row.Sources)),
+			}
+		}
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to add evaluation dataset items to dataset %s, got error: %s", datasetID, err))
+		}
+	}
+
+	return result
+}
+
+// apply reads the source file and reconciles the dataset, storing the resulting entries in the model.
+func (r *EvaluationDatasetItemsResource) apply(ctx context.Context, model *EvaluationDatasetItemsResourceModel, current map[string]EvaluationDatasetItemsEntryModel, diags *diag.Diagnostics) {
+	// source_path may only have become known during apply
+	format, err := evaluationDatasetItemsFormat(model.SourcePath.ValueString(), model.Format)
+	if err != nil {
+		diags.AddAttributeError(path.Root("format"), "Unknown Source Format", err.Error())
+		return
+	}
+	model.Format = types.StringValue(format)
+
+	opts := evaluationDatasetRowOptionsFromModel(ctx, *model, diags)
+	if diags.HasError() {
+		return
+	}
+	rows, err := readEvaluationDatasetRows(model.SourcePath.ValueString(), opts)
+	if err != nil {
+		diags.AddAttributeError(path.Root("source_path"), "Unable to Read Evaluation Dataset Items", fmt.Sprintf("Unable to read %s: %s", model.SourcePath.ValueString(), err))
+		return
+	}
+
+	model.ID = model.DatasetID
+	entries := r.syncItems(ctx, model.DatasetID.ValueString(), rows, current, diags)
+	itemsVal, mapDiags := types.MapValueFrom(ctx, types.ObjectType{AttrTypes: evaluationDatasetItemsEntryAttributeTypes()}, entries)
+	diags.Append(mapDiags...)
+	model.Items = itemsVal
+}
+
+func (r *EvaluationDatasetItemsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data EvaluationDatasetItemsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Loading Evaluation Dataset Items from %s into dataset %s", data.SourcePath.ValueString(), data.DatasetID.ValueString()))
+
+	r.apply(ctx, &data, map[string]EvaluationDatasetItemsEntryModel{}, &resp.Diagnostics)
+	if data.Items.IsNull() || data.Items.IsUnknown() {
+		return // Nothing was created
+	}
+
+	// Save partially created items as well, so that they are tracked and cleaned up.
+	tflog.Info(ctx, fmt.Sprintf("Loaded %d Evaluation Dataset Items into dataset %s", len(data.Items.Elements()), data.DatasetID.ValueString()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *EvaluationDatasetItemsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data EvaluationDatasetItemsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	datasetID := data.DatasetID.ValueString()
+	tflog.Debug(ctx, fmt.Sprintf("Reading Evaluation Dataset Items of dataset %s", datasetID))
+
+	items, err := r.client.ListEvaluationDatasetItems(ctx, datasetID)
+	if err != nil {
+		if errors.Is(err, coraxclient.ErrNotFound) {
+			tflog.Warn(ctx, fmt.Sprintf("Evaluation Dataset %s not found, removing items from state", datasetID))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read evaluation dataset items of dataset %s, got error: %s", datasetID, err))
+		return
+	}
+
+	byID := make(map[string]api.EvaluationDatasetItemRepresentation, len(items))
+	for _, item := range items {
+		byID[item.Id] = item
+	}
+
+	current := map[string]EvaluationDatasetItemsEntryModel{}
+	if !data.Items.IsNull() && !data.Items.IsUnknown() {
+		resp.Diagnostics.Append(data.Items.ElementsAs(ctx, &current, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Items deleted outside of Terraform are dropped, and items changed outside of Terraform get
+	// the hash of their current content, so the next plan re-creates or updates them.
+	refreshed := make(map[string]EvaluationDatasetItemsEntryModel, len(current))
+	for key, entry := range current {
+		item, ok := byID[entry.ItemID.ValueString()]
+		if !ok {
+			tflog.Warn(ctx, fmt.Sprintf("Evaluation Dataset Item %s for row %q not found", entry.ItemID.ValueString(), key))
+			continue
+		}
+		refreshed[key] = EvaluationDatasetItemsEntryModel{
+			ItemID:      entry.ItemID,
+			ContentHash: types.StringValue(hashEvaluationDatasetItem(item.Input, item.Output, item.GetSources())),
+		}
+	}
+
+	itemsVal, diags := types.MapValueFrom(ctx, types.ObjectType{AttrTypes: evaluationDatasetItemsEntryAttributeTypes()}, refreshed)
+	resp.Diagnostics.Append(diags...)
+	data.Items = itemsVal
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *EvaluationDatasetItemsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan EvaluationDatasetItemsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state EvaluationDatasetItemsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current := map[string]EvaluationDatasetItemsEntryModel{}
+	if !state.Items.IsNull() && !state.Items.IsUnknown() {
+		resp.Diagnostics.Append(state.Items.ElementsAs(ctx, &current, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating Evaluation Dataset Items of dataset %s from %s", plan.DatasetID.ValueString(), plan.SourcePath.ValueString()))
+
+	r.apply(ctx, &plan, current, &resp.Diagnostics)
+	if plan.Items.IsNull() || plan.Items.IsUnknown() {
+		return // The file couldn't be read, nothing was changed
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Evaluation Dataset Items of dataset %s updated", plan.DatasetID.ValueString()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *EvaluationDatasetItemsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data EvaluationDatasetItemsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	datasetID := data.DatasetID.ValueString()
+	tflog.Debug(ctx, fmt.Sprintf("Deleting Evaluation Dataset Items of dataset %s", datasetID))
+
+	current := map[string]EvaluationDatasetItemsEntryModel{}
+	if !data.Items.IsNull() && !data.Items.IsUnknown() {
+		resp.Diagnostics.Append(data.Items.ElementsAs(ctx, &current, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	for key, entry := range current {
+		itemID := entry.ItemID.ValueString()
+		err := r.client.DeleteEvaluationDatasetItem(ctx, datasetID, itemID)
+		if err != nil {
+			if errors.Is(err, coraxclient.ErrNotFound) {
+				tflog.Warn(ctx, fmt.Sprintf("Evaluation Dataset Item %s for row %q already deleted", itemID, key))
+				continue
+			}
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete evaluation dataset item %s for row %q, got error: %s", itemID, key, err))
+			return
+		}
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Deleted %d Evaluation Dataset Items of dataset %s", len(current), datasetID))
+}
@@ -0,0 +1,316 @@
+// Copyright (c) Trifork
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+
+	"terraform-provider-corax/internal/coraxclient"
+)
+
+func TestAccEvaluationDatasetItemsResource_csv(t *testing.T) {
+	if os.Getenv("CORAX_API_ENDPOINT") == "" || os.Getenv("CORAX_API_KEY") == "" {
+		t.Skip("Skipping acceptance test: CORAX_API_ENDPOINT or CORAX_API_KEY not set")
+	}
+
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	datasetName := fmt.Sprintf("tf-acc-test-dataset-items-%s", rName)
+	resourceName := "corax_evaluation_dataset_items.test"
+
+	sourcePath := filepath.Join(t.TempDir(), "items.csv")
+	writeRows := func(content string) {
+		if err := os.WriteFile(sourcePath, []byte(content), 0o600); err != nil {
+			t.Fatalf("Failed to write test rows: %v", err)
+		}
+	}
+	writeRows("id,question,answer\nq1,What is 6 x 7?,42\nq2,What is 2 + 2?,4\n")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccEvaluationDatasetItemsResourceConfig(datasetName, sourcePath),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "dataset_id", "corax_evaluation_dataset.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "format", "csv"),
+					resource.TestCheckResourceAttr(resourceName, "items.%", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "items.q1.item_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "items.q2.item_id"),
+				),
+			},
+			// Changing one row and adding another updates the resource in place
+			{
+				PreConfig: func() {
+					writeRows("id,question,answer\nq1,What is 6 x 7?,42\nq2,What is 2 + 3?,5\nq3,What is 3 x 3?,9\n")
+				},
+				Config: testAccEvaluationDatasetItemsResourceConfig(datasetName, sourcePath),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "items.%", "3"),
+					resource.TestCheckResourceAttrSet(resourceName, "items.q3.item_id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccEvaluationDatasetItemsResourceConfig(datasetName, sourcePath string) string {
+	return fmt.Sprintf(`
+provider "corax" {}
+
+resource "corax_evaluation_dataset" "test" {
+  name = %[1]q
+
+  configuration = {
+    input_variables = ["question"]
+    output_type     = "json"
+  }
+}
+
+resource "corax_evaluation_dataset_items" "test" {
+  dataset_id    = corax_evaluation_dataset.test.id
+  source_path   = %[2]q
+  key_column    = "id"
+  output_column = "answer"
+}
+`, datasetName, sourcePath)
+}
+
+func TestEvaluationDatasetItemsFormat(t *testing.T) {
+	tests := []struct {
+		name       string
+		sourcePath string
+		format     types.String
+		expected   string
+		expectErr  bool
+	}{
+		{name: "jsonl extension", sourcePath: "items.jsonl", format: types.StringNull(), expected: "jsonl"},
+		{name: "ndjson extension", sourcePath: "items.NDJSON", format: types.StringNull(), expected: "jsonl"},
+		{name: "csv extension", sourcePath: "data/items.csv", format: types.StringNull(), expected: "csv"},
+		{name: "explicit format wins", sourcePath: "items.txt", format: types.StringValue("csv"), expected: "csv"},
+		{name: "unknown extension", sourcePath: "items.txt", format: types.StringNull(), expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := evaluationDatasetItemsFormat(tt.sourcePath, tt.format)
+			if tt.expectErr {
+				if err == nil {
+					t.Fatal("Expected error but got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestReadEvaluationDatasetRows(t *testing.T) {
+	dir := t.TempDir()
+	writeFile := func(name, content string) string {
+		p := filepath.Join(dir, name)
+		if err := os.WriteFile(p, []byte(content), 0o600); err != nil {
+			t.Fatalf("Failed to write %s: %v", name, err)
+		}
+		return p
+	}
+
+	defaultOpts := func(format string) evaluationDatasetRowOptions {
+		return evaluationDatasetRowOptions{Format: format, OutputColumn: "output", SourcesColumn: "sources"}
+	}
+
+	t.Run("csv with json output and sources", func(t *testing.T) {
+		p := writeFile("basic.csv", "question,context,output,sources\n"+
+			"What is 6 x 7?,math,\"{\"\"answer\"\":\"\"42\"\"}\",\"[\"\"a\"\",\"\"b\"\"]\"\n"+
+			"Sky color?,nature,blue,wiki\n")
+
+		rows, err := readEvaluationDatasetRows(p, defaultOpts("csv"))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		expected := []evaluationDatasetRow{
+			{
+				Key:     "1",
+				Input:   map[string]string{"question": "What is 6 x 7?", "context": "math"},
+				Output:  map[string]interface{}{"answer": "42"},
+				Sources: []string{"a", "b"},
+			},
+			{
+				Key:     "2",
+				Input:   map[string]string{"question": "Sky color?", "context": "nature"},
+				Output:  map[string]interface{}{"output": "blue"},
+				Sources: []string{"wiki"},
+			},
+		}
+		if !reflect.DeepEqual(rows, expected) {
+			t.Errorf("Expected %+v, got %+v", expected, rows)
+		}
+	})
+
+	t.Run("jsonl with key and input columns", func(t *testing.T) {
+		p := writeFile("basic.jsonl", `{"id": "q1", "question": "What is 6 x 7?", "n": 7, "ignored": "x", "output": {"answer": 42}, "sources": ["calc"]}`+"\n\n"+
+			`{"id": "q2", "question": "What is 2 + 2?", "n": 2, "output": "4"}`+"\n")
+
+		opts := defaultOpts("jsonl")
+		opts.KeyColumn = "id"
+		opts.InputColumns = []string{"question", "n"}
+		rows, err := readEvaluationDatasetRows(p, opts)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		expected := []evaluationDatasetRow{
+			{
+				Key:     "q1",
+				Input:   map[string]string{"question": "What is 6 x 7?", "n": "7"},
+				Output:  map[string]interface{}{"answer": float64(42)},
+				Sources: []string{"calc"},
+			},
+			{
+				Key:    "q2",
+				Input:  map[string]string{"question": "What is 2 + 2?", "n": "2"},
+				Output: map[string]interface{}{"output": "4"},
+			},
+		}
+		if !reflect.DeepEqual(rows, expected) {
+			t.Errorf("Expected %+v, got %+v", expected, rows)
+		}
+	})
+
+	t.Run("duplicate key", func(t *testing.T) {
+		p := writeFile("duplicate.csv", "id,question,output\nq1,a,1\nq1,b,2\n")
+
+		opts := defaultOpts("csv")
+		opts.KeyColumn = "id"
+		if _, err := readEvaluationDatasetRows(p, opts); err == nil {
+			t.Fatal("Expected error for duplicate key")
+		}
+	})
+
+	t.Run("missing output column", func(t *testing.T) {
+		p := writeFile("no_output.jsonl", `{"question": "a"}`+"\n")
+
+		if _, err := readEvaluationDatasetRows(p, defaultOpts("jsonl")); err == nil {
+			t.Fatal("Expected error for missing output column")
+		}
+	})
+
+	t.Run("invalid jsonl line", func(t *testing.T) {
+		p := writeFile("invalid.jsonl", `{"question": "a", "output": "b"}`+"\nnot json\n")
+
+		if _, err := readEvaluationDatasetRows(p, defaultOpts("jsonl")); err == nil {
+			t.Fatal("Expected error for invalid JSONL line")
+		}
+	})
+}
+
+func TestHashEvaluationDatasetItem(t *testing.T) {
+	base := hashEvaluationDatasetItem(
+		map[string]string{"a": "1", "b": "2"},
+		map[string]interface{}{"answer": 42},
+		nil,
+	)
+
+	// Key order, number representation and nil vs empty sources don't affect the hash.
+	same := hashEvaluationDatasetItem(
+		map[string]string{"b": "2", "a": "1"},
+		map[string]interface{}{"answer": float64(42)},
+		[]string{},
+	)
+	if base != same {
+		t.Errorf("Expected equal hashes, got %s and %s", base, same)
+	}
+
+	changed := hashEvaluationDatasetItem(
+		map[string]string{"a": "1", "b": "2"},
+		map[string]interface{}{"answer": 43},
+		nil,
+	)
+	if base == changed {
+		t.Error("Expected different hashes for different output")
+	}
+}
+
+func TestEvaluationDatasetItemsSyncItems_updateError(t *testing.T) {
+	var updated []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method != http.MethodPut {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		itemID := path.Base(r.URL.Path)
+		updated = append(updated, itemID)
+		if itemID == "item-b" {
+			w.WriteHeader(http.StatusInternalServerError)
+			_ = json.NewEncoder(w).Encode(map[string]string{"detail": "boom"})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"id": itemID, "input": map[string]string{}, "output": map[string]interface{}{}})
+	}))
+	defer server.Close()
+
+	client, err := coraxclient.NewClient(server.URL, "test-api-key")
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	r := &EvaluationDatasetItemsResource{client: client}
+
+	rows := []evaluationDatasetRow{
+		{Key: "a", Input: map[string]string{"q": "a2"}, Output: map[string]interface{}{"answer": "a2"}},
+		{Key: "b", Input: map[string]string{"q": "b2"}, Output: map[string]interface{}{"answer": "b2"}},
+		{Key: "c", Input: map[string]string{"q": "c2"}, Output: map[string]interface{}{"answer": "c2"}},
+	}
+	current := map[string]EvaluationDatasetItemsEntryModel{
+		"a": {ItemID: types.StringValue("item-a"), ContentHash: types.StringValue("old-a")},
+		"b": {ItemID: types.StringValue("item-b"), ContentHash: types.StringValue("old-b")},
+		"c": {ItemID: types.StringValue("item-c"), ContentHash: types.StringValue("old-c")},
+	}
+
+	var diags diag.Diagnostics
+	result := r.syncItems(context.Background(), "dataset-1", rows, current, &diags)
+	if !diags.HasError() {
+		t.Fatal("Expected an error for the failing update")
+	}
+	if !reflect.DeepEqual(updated, []string{"item-a", "item-b"}) {
+		t.Errorf("Expected updates to stop after the failing item, got %v", updated)
+	}
+
+	expected := map[string]EvaluationDatasetItemsEntryModel{
+		"a": {ItemID: types.StringValue("item-a"), ContentHash: types.StringValue(hashEvaluationDatasetItem(rows[0].Input, rows[0].Output, nil))},
+		"b": current["b"],
+		"c": current["c"],
+	}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright (c) Trifork
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccEvaluationDatasetResource_basic(t *testing.T) {
+	if os.Getenv("CORAX_API_ENDPOINT") == "" || os.Getenv("CORAX_API_KEY") == "" {
+		t.Skip("Skipping acceptance test: CORAX_API_ENDPOINT or CORAX_API_KEY not set")
+	}
+
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	datasetName := fmt.Sprintf("tf-acc-test-dataset-%s", rName)
+	resourceName := "corax_evaluation_dataset.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccEvaluationDatasetResourceConfig(datasetName, "Initial description"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", datasetName),
+					resource.TestCheckResourceAttr(resourceName, "is_public", "false"), // Default
+					resource.TestCheckResourceAttr(resourceName, "configuration.description", "Initial description"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.output_type", "json"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.input_variables.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.input_variables.0", "question"),
+					resource.TestCheckResourceAttr(resourceName, "items_count", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The item count and timestamp may change as the dataset is read.
+				ImportStateVerifyIgnore: []string{"updated_at", "items_count"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccEvaluationDatasetResourceConfig(datasetName+"-updated", "Updated description"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", datasetName+"-updated"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.description", "Updated description"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccEvaluationDatasetResourceConfig(name, description string) string {
+	return fmt.Sprintf(`
+provider "corax" {}
+
+resource "corax_evaluation_dataset" "test" {
+  name = %[1]q
+
+  configuration = {
+    description     = %[2]q
+    input_variables = ["question"]
+    output_type     = "json"
+    output_schema_def = jsonencode({
+      type = "object"
+      properties = {
+        answer = { type = "string" }
+      }
+    })
+  }
+}
+`, name, description)
+}
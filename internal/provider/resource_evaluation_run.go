@@ -0,0 +1,676 @@
+// Copyright (c) Trifork
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-corax/internal/coraxclient"
+	api "terraform-provider-corax/internal/generated"
+)
+
+const (
+	evaluationRunDefaultTimeout = "30m"
+	evaluationRunPollInterval   = 5 * time.Second
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &EvaluationRunResource{}
+var _ resource.ResourceWithImportState = &EvaluationRunResource{}
+var _ resource.ResourceWithValidateConfig = &EvaluationRunResource{}
+
+func NewEvaluationRunResource() resource.Resource {
+	return &EvaluationRunResource{}
+}
+
+// EvaluationRunResource defines the resource implementation.
+type EvaluationRunResource struct {
+	client *coraxclient.Client
+}
+
+// EvaluationRunResourceModel describes the resource data model.
+// Based on components.schemas.EvaluationExecutionRepresentation.
+type EvaluationRunResourceModel struct {
+	ID                       types.String  `tfsdk:"id"`
+	EvaluationID             types.String  `tfsdk:"evaluation_id"`
+	Mode                     types.String  `tfsdk:"mode"`
+	DatasetItemIDs           types.List    `tfsdk:"dataset_item_ids"` // List of strings
+	Triggers                 types.Map     `tfsdk:"triggers"`
+	Timeout                  types.String  `tfsdk:"timeout"`
+	Status                   types.String  `tfsdk:"status"`
+	StatusDisplay            types.String  `tfsdk:"status_display"`
+	Passed                   types.Bool    `tfsdk:"passed"`
+	SuccessRate              types.Float64 `tfsdk:"success_rate"`
+	SuccessThreshold         types.Float64 `tfsdk:"success_threshold"`
+	IsTestRun                types.Bool    `tfsdk:"is_test_run"`
+	SelectedDatasetItemCount types.Int64   `tfsdk:"selected_dataset_item_count"`
+	Progress                 types.Object  `tfsdk:"progress"`         // EvaluationRunProgressModel
+	CriteriaResults          types.List    `tfsdk:"criteria_results"` // List of EvaluationRunCriterionResultModel
+	CreatedAt                types.String  `tfsdk:"created_at"`
+}
+
+// EvaluationRunProgressModel maps to components.schemas.EvaluationExecutionProgress.
+type EvaluationRunProgressModel struct {
+	Total      types.Int64 `tfsdk:"total"`
+	Pending    types.Int64 `tfsdk:"pending"`
+	Successful types.Int64 `tfsdk:"successful"`
+	Failed     types.Int64 `tfsdk:"failed"`
+}
+
+// EvaluationRunCriterionResultModel maps to components.schemas.EvaluationCriterionExecutionRepresentation.
+type EvaluationRunCriterionResultModel struct {
+	ID          types.String `tfsdk:"id"`
+	CriterionID types.String `tfsdk:"criterion_id"`
+	Type        types.String `tfsdk:"type"`
+	Status      types.String `tfsdk:"status"`
+	Successful  types.Bool   `tfsdk:"successful"`
+	TestsTotal  types.Int64  `tfsdk:"tests_total"`
+	TestsPassed types.Int64  `tfsdk:"tests_passed"`
+}
+
+func evaluationRunProgressAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"total":      types.Int64Type,
+		"pending":    types.Int64Type,
+		"successful": types.Int64Type,
+		"failed":     types.Int64Type,
+	}
+}
+
+func evaluationRunCriterionResultAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":           types.StringType,
+		"criterion_id": types.StringType,
+		"type":         types.StringType,
+		"status":       types.StringType,
+		"successful":   types.BoolType,
+		"tests_total":  types.Int64Type,
+		"tests_passed": types.Int64Type,
+	}
+}
+
+// evaluationExecutionFinished reports whether the execution reached a terminal status.
+func evaluationExecutionFinished(status api.EvaluationExecutionStatus) bool {
+	switch status {
+	case api.COMPLETED, api.FAILED, api.CANCELLED:
+		return true
+	}
+	return false
+}
+
+// evaluationExecutionScore returns the score of a completed execution as a fraction between 0 and 1.
+// The success rate is used when the API reports one, otherwise the execution's pass/fail outcome.
+// The score is kept as the API's float32 so it compares exactly with the success threshold.
+func evaluationExecutionScore(execution *api.EvaluationExecutionRepresentation) (float32, bool) {
+	if rate, ok := execution.GetSuccessRateOk(); ok && rate != nil {
+		return *rate, true
+	}
+	if passed, ok := execution.GetPassedOk(); ok && passed != nil {
+		if *passed {
+			return 1, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+// failedCriteriaSummary lists the criteria that did not succeed, for diagnostics.
+func failedCriteriaSummary(results []EvaluationRunCriterionResultModel) string {
+	var failed []string
+	for _, result := range results {
+		if result.Successful.ValueBool() {
+			continue
+		}
+		name := result.Type.ValueString()
+		if name == "" {
+			name = result.CriterionID.ValueString()
+		}
+		failed = append(failed, fmt.Sprintf("  - %s (criterion %s): %d/%d test cases passed, status %s",
+			name, result.CriterionID.ValueString(), result.TestsPassed.ValueInt64(), result.TestsTotal.ValueInt64(), result.Status.ValueString()))
+	}
+	sort.Strings(failed)
+	return strings.Join(failed, "\n")
+}
+
+// mapEvaluationExecutionToModel maps an api.EvaluationExecutionRepresentation to the Terraform model.
+// criterionTypes maps criterion IDs to their type and may be empty.
+func mapEvaluationExecutionToModel(ctx context.Context, execution *api.EvaluationExecutionRepresentation, criterionExecutions []api.EvaluationCriterionExecutionRepresentation, criterionTypes map[string]string, model *EvaluationRunResourceModel, diags *diag.Diagnostics) []EvaluationRunCriterionResultModel {
+	model.ID = types.StringValue(execution.Id)
+	if evaluationID, ok := execution.GetEvaluationIdOk(); ok && evaluationID != nil && *evaluationID != "" {
+		model.EvaluationID = types.StringValue(*evaluationID)
+	}
+	if execution.Configuration != nil && execution.Configuration.Mode != nil {
+		model.Mode = types.StringValue(string(*execution.Configuration.Mode))
+	} else if model.Mode.IsNull() || model.Mode.IsUnknown() {
+		model.Mode = types.StringValue(string(api.FULL))
+	}
+	// Keep the configured item IDs, only fill them in when unknown (e.g. on import).
+	if model.DatasetItemIDs.IsNull() || model.DatasetItemIDs.IsUnknown() {
+		if execution.Configuration != nil && len(execution.Configuration.DatasetItemIds) > 0 {
+			listVal, listDiags := types.ListValueFrom(ctx, types.StringType, execution.Configuration.DatasetItemIds)
+			diags.Append(listDiags...)
+			model.DatasetItemIDs = listVal
+		} else {
+			model.DatasetItemIDs = types.ListNull(types.StringType)
+		}
+	}
+
+	model.Status = types.StringValue(string(execution.Status))
+	model.StatusDisplay = types.StringValue(execution.StatusDisplay)
+	if passed, ok := execution.GetPassedOk(); ok && passed != nil {
+		model.Passed = types.BoolValue(*passed)
+	} else {
+		model.Passed = types.BoolNull()
+	}
+	if rate, ok := execution.GetSuccessRateOk(); ok && rate != nil {
+		model.SuccessRate = types.Float64Value(float32ToFloat64(*rate))
+	} else {
+		model.SuccessRate = types.Float64Null()
+	}
+	model.IsTestRun = types.BoolValue(execution.IsTestRun)
+	model.SelectedDatasetItemCount = types.Int64Value(int64(execution.SelectedDatasetItemCount))
+	model.CreatedAt = types.StringValue(execution.CreatedAt.Format(time.RFC3339))
+
+	progress := EvaluationRunProgressModel{
+		Total:      types.Int64Value(int64(execution.Progress.Total)),
+		Pending:    types.Int64Value(int64(execution.Progress.Pending)),
+		Successful: types.Int64Value(int64(execution.Progress.Successful)),
+		Failed:     types.Int64Value(int64(execution.Progress.Failed)),
+	}
+	progressVal, progressDiags := types.ObjectValueFrom(ctx, evaluationRunProgressAttributeTypes(), progress)
+	diags.Append(progressDiags...)
+	model.Progress = progressVal
+
+	results := make([]EvaluationRunCriterionResultModel, 0, len(criterionExecutions))
+	for _, criterionExecution := range criterionExecutions {
+		result := EvaluationRunCriterionResultModel{
+			ID:          types.StringValue(criterionExecution.Id),
+			CriterionID: types.StringNull(),
+			Type:        types.StringNull(),
+			Status:      types.StringValue(string(criterionExecution.Status)),
+			Successful:  types.BoolNull(),
+			TestsTotal:  types.Int64Value(0),
+			TestsPassed: types.Int64Value(0),
+		}
+		if criterionID, ok := criterionExecution.GetCriterionIdOk(); ok && criterionID != nil && *criterionID != "" {
+			result.CriterionID = types.StringValue(*criterionID)
+			if criterionType, ok := criterionTypes[*criterionID]; ok {
+				result.Type = types.StringValue(criterionType)
+			}
+		}
+		if apiResult, ok := criterionExecution.GetResultOk(); ok && apiResult != nil {
+			if successful, ok := apiResult.GetSuccessfulOk(); ok && successful != nil {
+				result.Successful = types.BoolValue(*successful)
+			}
+			passed := 0
+			for _, testResult := range apiResult.TestResults {
+				if testResult.Success {
+					passed++
+				}
+			}
+			result.TestsTotal = types.Int64Value(int64(len(apiResult.TestResults)))
+			result.TestsPassed = types.Int64Value(int64(passed))
+		}
+		results = append(results, result)
+	}
+	resultsVal, resultsDiags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: evaluationRunCriterionResultAttributeTypes()}, results)
+	diags.Append(resultsDiags...)
+	model.CriteriaResults = resultsVal
+
+	return results
+}
+
+func (r *EvaluationRunResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_evaluation_run"
+}
+
+func (r *EvaluationRunResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Runs a Corax Capability Evaluation and waits for it to finish, so applies can be gated on evaluation results. " +
+			"The apply fails when the run fails, times out, or scores below the evaluation's `success_threshold`; the resource is then tainted and the evaluation is run again on the next apply. " +
+			"Use `triggers` to run the evaluation again whenever the evaluated capability changes. Destroying the resource only removes it from the Terraform state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier for the evaluation execution.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"evaluation_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The UUID of the capability evaluation to run. Changing this runs the evaluation again.",
+				Validators:          []validator.String{uuidValidator()},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"mode": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(string(api.FULL)),
+				MarkdownDescription: "Whether to run the `full` evaluation dataset or a `subset` of it selected with `dataset_item_ids`. Defaults to `full`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(api.FULL), string(api.SUBSET)),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"dataset_item_ids": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "The IDs of the evaluation dataset items to run. Required when `mode` is `subset`.",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Arbitrary values that run the evaluation again when they change, e.g. the capability's `system_prompt`.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(evaluationRunDefaultTimeout),
+				MarkdownDescription: "How long to wait for the evaluation to finish, as a duration such as `10m` or `1h`. Defaults to `30m`.",
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The status of the execution (`pending`, `in_progress`, `completed`, `failed` or `cancelled`).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status_display": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Human-readable status, e.g. `Passed` or `Not Passed`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"passed": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the execution passed according to the API.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"success_rate": schema.Float64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The fraction of criteria that succeeded.",
+				PlanModifiers: []planmodifier.Float64{
+					float64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"success_threshold": schema.Float64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The evaluation's success threshold the run was gated on.",
+				PlanModifiers: []planmodifier.Float64{
+					float64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_test_run": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the execution only ran a subset of the dataset.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"selected_dataset_item_count": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The number of dataset items the execution ran.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"progress": schema.SingleNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Progress of the execution, counted in criteria.",
+				PlanModifiers:       []planmodifier.Object{objectplanmodifier.UseStateForUnknown()},
+				Attributes: map[string]schema.Attribute{
+					"total":      schema.Int64Attribute{Computed: true, MarkdownDescription: "Total number of criteria to evaluate."},
+					"pending":    schema.Int64Attribute{Computed: true, MarkdownDescription: "Number of criteria pending evaluation."},
+					"successful": schema.Int64Attribute{Computed: true, MarkdownDescription: "Number of criteria that succeeded."},
+					"failed":     schema.Int64Attribute{Computed: true, MarkdownDescription: "Number of criteria that failed."},
+				},
+			},
+			"criteria_results": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Per-criterion results of the execution.",
+				PlanModifiers:       []planmodifier.List{listplanmodifier.UseStateForUnknown()},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id":           schema.StringAttribute{Computed: true, MarkdownDescription: "The ID of the criterion execution."},
+						"criterion_id": schema.StringAttribute{Computed: true, MarkdownDescription: "The ID of the evaluated criterion."},
+						"type":         schema.StringAttribute{Computed: true, MarkdownDescription: "The type of the evaluated criterion, if it still exists."},
+						"status":       schema.StringAttribute{Computed: true, MarkdownDescription: "The status of the criterion execution."},
+						"successful":   schema.BoolAttribute{Computed: true, MarkdownDescription: "Whether the criterion succeeded."},
+						"tests_total":  schema.Int64Attribute{Computed: true, MarkdownDescription: "Number of test cases evaluated for the criterion."},
+						"tests_passed": schema.Int64Attribute{Computed: true, MarkdownDescription: "Number of test cases that passed the criterion."},
+					},
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The date and time the execution was started (RFC3339 format).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *EvaluationRunResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*coraxclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *coraxclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *EvaluationRunResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data EvaluationRunResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Timeout.IsNull() && !data.Timeout.IsUnknown() {
+		if timeout, err := time.ParseDuration(data.Timeout.ValueString()); err != nil || timeout <= 0 {
+			resp.Diagnostics.AddAttributeError(path.Root("timeout"), "Invalid Timeout",
+				fmt.Sprintf("Expected a positive duration such as \"30m\", got %q.", data.Timeout.ValueString()))
+		}
+	}
+
+	if data.Mode.IsUnknown() || data.DatasetItemIDs.IsUnknown() {
+		return
+	}
+	subset := data.Mode.ValueString() == string(api.SUBSET)
+	hasItems := !data.DatasetItemIDs.IsNull() && len(data.DatasetItemIDs.Elements()) > 0
+	if subset && !hasItems {
+		resp.Diagnostics.AddAttributeError(path.Root("dataset_item_ids"), "Missing Dataset Items",
+			"dataset_item_ids must contain at least one item when mode is \"subset\".")
+	}
+	if !subset && hasItems {
+		resp.Diagnostics.AddAttributeError(path.Root("dataset_item_ids"), "Unexpected Dataset Items",
+			"dataset_item_ids can only be set when mode is \"subset\".")
+	}
+}
+
+// criterionTypesByID returns the criterion types of an evaluation keyed by criterion ID. Errors are
+// only logged, as the types are informational.
+func (r *EvaluationRunResource) criterionTypesByID(ctx context.Context, evaluationID string) map[string]string {
+	criterionTypes := map[string]string{}
+	criteria, err := r.client.ListEvaluationCriteria(ctx, evaluationID)
+	if err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("Unable to read criteria of capability evaluation %s: %s", evaluationID, err))
+		return criterionTypes
+	}
+	for _, criterion := range criteria {
+		criterionTypes[criterion.GetId()] = string(criterion.Type)
+	}
+	return criterionTypes
+}
+
+// successThreshold returns the success threshold of an evaluation as the API's float32, defaulting to 1 like the API.
+func (r *EvaluationRunResource) successThreshold(ctx context.Context, evaluationID string) (float32, error) {
+	evaluation, err := r.client.GetCapabilityEvaluation(ctx, evaluationID)
+	if err != nil {
+		return 0, err
+	}
+	if value, ok := evaluation.Configuration.GetSuccessThresholdOk(); ok && value != nil {
+		return *value, nil
+	}
+	return 1, nil
+}
+
+// waitForExecution polls the execution until it finishes or the timeout expires. On timeout the
+// last known state of the execution is returned together with the error.
+func (r *EvaluationRunResource) waitForExecution(ctx context.Context, evaluationID string, executionID string, timeout time.Duration) (*api.EvaluationExecutionRepresentation, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(evaluationRunPollInterval)
+	defer ticker.Stop()
+
+	var execution *api.EvaluationExecutionRepresentation
+	for {
+		select {
+		case <-ctx.Done():
+			return execution, fmt.Errorf("evaluation execution %s did not finish within %s", executionID, timeout)
+		case <-ticker.C:
+		}
+
+		current, err := r.client.GetEvaluationExecution(ctx, evaluationID, executionID)
+		if err != nil {
+			if ctx.Err() != nil {
+				continue // Reported as a timeout on the next iteration.
+			}
+			return execution, err
+		}
+		execution = current
+
+		tflog.Debug(ctx, fmt.Sprintf("Evaluation execution %s is %s: %d/%d criteria done (%d failed)", executionID, execution.Status,
+			execution.Progress.Total-execution.Progress.Pending, execution.Progress.Total, execution.Progress.Failed))
+		if evaluationExecutionFinished(execution.Status) {
+			return execution, nil
+		}
+	}
+}
+
+func (r *EvaluationRunResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data EvaluationRunResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	evaluationID := data.EvaluationID.ValueString()
+	timeout, err := time.ParseDuration(data.Timeout.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("timeout"), "Invalid Timeout", err.Error())
+		return
+	}
+
+	threshold, err := r.successThreshold(ctx, evaluationID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read capability evaluation %s, got error: %s", evaluationID, err))
+		return
+	}
+	data.SuccessThreshold = types.Float64Value(float32ToFloat64(threshold))
+
+	configuration := api.NewEvaluationExecutionConfiguration()
+	configuration.SetMode(api.EvaluationExecutionMode(data.Mode.ValueString()))
+	if !data.DatasetItemIDs.IsNull() && !data.DatasetItemIDs.IsUnknown() {
+		var itemIDs []string
+		resp.Diagnostics.Append(data.DatasetItemIDs.ElementsAs(ctx, &itemIDs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		configuration.SetDatasetItemIds(itemIDs)
+	}
+	payload := api.NewEvaluationExecutionCreate(evaluationID)
+	payload.SetConfiguration(*configuration)
+
+	tflog.Debug(ctx, fmt.Sprintf("Starting execution of Capability Evaluation %s in %s mode", evaluationID, data.Mode.ValueString()))
+	execution, err := r.client.ExecuteCapabilityEvaluation(ctx, evaluationID, *payload)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to start execution of capability evaluation %s, got error: %s", evaluationID, err))
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("Evaluation execution started with ID: %s", execution.Id))
+
+	if !evaluationExecutionFinished(execution.Status) {
+		finished, waitErr := r.waitForExecution(ctx, evaluationID, execution.Id, timeout)
+		if finished != nil {
+			execution = finished
+		}
+		if waitErr != nil {
+			// Save what is known, the failed apply taints the resource so the evaluation runs again.
+			mapEvaluationExecutionToModel(ctx, execution, nil, nil, &data, &resp.Diagnostics)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.AddError("Evaluation Run Not Finished",
+				fmt.Sprintf("Unable to wait for execution %s of capability evaluation %s: %s. %d of %d criteria are still pending.",
+					execution.Id, evaluationID, waitErr, execution.Progress.Pending, execution.Progress.Total))
+			return
+		}
+	}
+
+	criterionExecutions, err := r.client.ListEvaluationCriterionExecutions(ctx, evaluationID, execution.Id)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read criteria results of evaluation execution %s, got error: %s", execution.Id, err))
+	}
+	results := mapEvaluationExecutionToModel(ctx, execution, criterionExecutions, r.criterionTypesByID(ctx, evaluationID), &data, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if execution.Status != api.COMPLETED {
+		resp.Diagnostics.AddError("Evaluation Run Failed",
+			fmt.Sprintf("Execution %s of capability evaluation %s ended with status %q (%s).", execution.Id, evaluationID, execution.Status, execution.StatusDisplay))
+		return
+	}
+
+	score, ok := evaluationExecutionScore(execution)
+	if !ok {
+		resp.Diagnostics.AddError("Evaluation Run Without Score",
+			fmt.Sprintf("Execution %s of capability evaluation %s completed without a success rate, so it can't be compared with the success threshold %v.", execution.Id, evaluationID, threshold))
+		return
+	}
+	if score < threshold {
+		detail := fmt.Sprintf("Execution %s of capability evaluation %s scored %v, below the success threshold %v.", execution.Id, evaluationID, score, threshold)
+		if summary := failedCriteriaSummary(results); summary != "" {
+			detail += "\n\nFailed criteria:\n" + summary
+		}
+		resp.Diagnostics.AddError("Evaluation Run Below Success Threshold", detail)
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Evaluation execution %s passed with score %v (threshold %v)", execution.Id, score, threshold))
+}
+
+func (r *EvaluationRunResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data EvaluationRunResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	evaluationID := data.EvaluationID.ValueString()
+	executionID := data.ID.ValueString()
+	tflog.Debug(ctx, fmt.Sprintf("Reading Evaluation Execution %s of Capability Evaluation %s", executionID, evaluationID))
+
+	execution, err := r.client.GetEvaluationExecution(ctx, evaluationID, executionID)
+	if err != nil {
+		if errors.Is(err, coraxclient.ErrNotFound) {
+			tflog.Warn(ctx, fmt.Sprintf("Evaluation Execution %s not found, removing from state", executionID))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read evaluation execution %s, got error: %s", executionID, err))
+		return
+	}
+
+	criterionExecutions, err := r.client.ListEvaluationCriterionExecutions(ctx, evaluationID, executionID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read criteria results of evaluation execution %s, got error: %s", executionID, err))
+		return
+	}
+
+	// Imported runs have no threshold recorded yet.
+	if data.SuccessThreshold.IsNull() || data.SuccessThreshold.IsUnknown() {
+		threshold, err := r.successThreshold(ctx, evaluationID)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read capability evaluation %s, got error: %s", evaluationID, err))
+			return
+		}
+		data.SuccessThreshold = types.Float64Value(float32ToFloat64(threshold))
+	}
+	if data.Timeout.IsNull() || data.Timeout.IsUnknown() {
+		data.Timeout = types.StringValue(evaluationRunDefaultTimeout)
+	}
+
+	mapEvaluationExecutionToModel(ctx, execution, criterionExecutions, r.criterionTypesByID(ctx, evaluationID), &data, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update only handles changes to timeout, all other arguments require a new run.
+func (r *EvaluationRunResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan EvaluationRunResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state EvaluationRunResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Timeout = plan.Timeout
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *EvaluationRunResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data EvaluationRunResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Evaluation executions are kept as history in Corax, there is nothing to delete.
+	tflog.Info(ctx, fmt.Sprintf("Evaluation Execution %s removed from state", data.ID.ValueString()))
+}
+
+func (r *EvaluationRunResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: evaluation_id/execution_id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("evaluation_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+}
@@ -0,0 +1,185 @@
+// Copyright (c) Trifork
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	api "terraform-provider-corax/internal/generated"
+)
+
+func TestAccEvaluationRunResource_basic(t *testing.T) {
+	if os.Getenv("CORAX_API_ENDPOINT") == "" || os.Getenv("CORAX_API_KEY") == "" {
+		t.Skip("Skipping acceptance test: CORAX_API_ENDPOINT or CORAX_API_KEY not set")
+	}
+
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	name := fmt.Sprintf("tf-acc-test-evaluation-run-%s", rName)
+	resourceName := "corax_evaluation_run.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccEvaluationRunResourceConfig(name, "You are a helpful assistant."),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "evaluation_id", "corax_capability_evaluation.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "mode", "full"),
+					resource.TestCheckResourceAttr(resourceName, "status", "completed"),
+					resource.TestCheckResourceAttr(resourceName, "success_threshold", "0"),
+					resource.TestCheckResourceAttr(resourceName, "criteria_results.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "criteria_results.0.type", "answer_relevancy"),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+				),
+			},
+			// Changing a trigger runs the evaluation again
+			{
+				Config: testAccEvaluationRunResourceConfig(name, "You are a very helpful assistant."),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "status", "completed"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccEvaluationRunResourceConfig(name, systemPrompt string) string {
+	return fmt.Sprintf(`
+provider "corax" {}
+
+resource "corax_chat_capability" "test" {
+  name          = %[1]q
+  system_prompt = %[2]q
+}
+
+resource "corax_evaluation_dataset" "test" {
+  name = %[1]q
+
+  configuration = {
+    input_variables = ["question"]
+    output_type     = "json"
+  }
+}
+
+resource "corax_evaluation_dataset_item" "test" {
+  dataset_id = corax_evaluation_dataset.test.id
+  input = {
+    question = "What is the capital of Denmark?"
+  }
+  output = jsonencode({ answer = "Copenhagen" })
+}
+
+resource "corax_capability_evaluation" "test" {
+  name                  = %[1]q
+  capability_id         = corax_chat_capability.test.id
+  evaluation_dataset_id = corax_evaluation_dataset.test.id
+  success_threshold     = 0
+
+  criterion {
+    type = "answer_relevancy"
+  }
+}
+
+resource "corax_evaluation_run" "test" {
+  evaluation_id = corax_capability_evaluation.test.id
+  timeout       = "20m"
+
+  triggers = {
+    system_prompt = corax_chat_capability.test.system_prompt
+  }
+
+  depends_on = [corax_evaluation_dataset_item.test]
+}
+`, name, systemPrompt)
+}
+
+func TestEvaluationExecutionScore(t *testing.T) {
+	execution := api.NewEvaluationExecutionRepresentationWithDefaults()
+
+	if _, ok := evaluationExecutionScore(execution); ok {
+		t.Error("Expected no score without success rate or passed")
+	}
+
+	execution.SetPassed(false)
+	if score, ok := evaluationExecutionScore(execution); !ok || score != 0 {
+		t.Errorf("Expected score 0 from passed=false, got %v (ok: %v)", score, ok)
+	}
+
+	execution.SetSuccessRate(0.8)
+	if score, ok := evaluationExecutionScore(execution); !ok || score != 0.8 {
+		t.Errorf("Expected score 0.8 from success rate, got %v (ok: %v)", score, ok)
+	}
+
+	// A score just below the threshold must not pass through rounding.
+	execution.SetSuccessRate(0.99996)
+	if score, ok := evaluationExecutionScore(execution); !ok || score >= 1 {
+		t.Errorf("Expected score below 1, got %v (ok: %v)", score, ok)
+	}
+}
+
+func TestMapEvaluationExecutionToModel(t *testing.T) {
+	mode := api.SUBSET
+	execution := api.EvaluationExecutionRepresentation{
+		Id:                       "exec-1",
+		Status:                   api.COMPLETED,
+		StatusDisplay:            "Not Passed",
+		Progress:                 api.EvaluationExecutionProgress{Total: 2, Successful: 1, Failed: 1},
+		Configuration:            &api.EvaluationExecutionConfiguration{Mode: &mode, DatasetItemIds: []string{"item-1"}},
+		CreatedAt:                time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		IsTestRun:                true,
+		SelectedDatasetItemCount: 1,
+	}
+	execution.SetEvaluationId("eval-1")
+	execution.SetSuccessRate(0.5)
+	execution.SetPassed(false)
+
+	passed := api.NewEvaluationCriterionExecutionResult([]api.TestResult{{Success: true}, {Success: true}})
+	passed.SetSuccessful(true)
+	failed := api.NewEvaluationCriterionExecutionResult([]api.TestResult{{Success: true}, {Success: false}})
+	failed.SetSuccessful(false)
+	criterionExecutions := []api.EvaluationCriterionExecutionRepresentation{
+		{Id: "ce-1", Status: api.COMPLETED, Result: *api.NewNullableEvaluationCriterionExecutionResult(passed), CriterionId: *api.NewNullableString(api.PtrString("crit-1"))},
+		{Id: "ce-2", Status: api.COMPLETED, Result: *api.NewNullableEvaluationCriterionExecutionResult(failed), CriterionId: *api.NewNullableString(api.PtrString("crit-2"))},
+	}
+
+	model := EvaluationRunResourceModel{
+		DatasetItemIDs: types.ListNull(types.StringType),
+		Mode:           types.StringNull(),
+	}
+	var diags diag.Diagnostics
+	results := mapEvaluationExecutionToModel(context.Background(), &execution, criterionExecutions,
+		map[string]string{"crit-1": "correctness", "crit-2": "bleu"}, &model, &diags)
+	if diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+
+	if model.ID.ValueString() != "exec-1" || model.EvaluationID.ValueString() != "eval-1" {
+		t.Errorf("Unexpected IDs: %s, %s", model.ID, model.EvaluationID)
+	}
+	if model.Mode.ValueString() != "subset" || len(model.DatasetItemIDs.Elements()) != 1 {
+		t.Errorf("Expected subset mode with one item, got %s, %s", model.Mode, model.DatasetItemIDs)
+	}
+	if model.SuccessRate.ValueFloat64() != 0.5 || model.Passed.ValueBool() {
+		t.Errorf("Unexpected outcome: rate %s, passed %s", model.SuccessRate, model.Passed)
+	}
+	if len(results) != 2 || results[1].Type.ValueString() != "bleu" || results[1].TestsPassed.ValueInt64() != 1 || results[1].TestsTotal.ValueInt64() != 2 {
+		t.Fatalf("Unexpected criteria results: %+v", results)
+	}
+
+	expectedSummary := "  - bleu (criterion crit-2): 1/2 test cases passed, status completed"
+	if summary := failedCriteriaSummary(results); summary != expectedSummary {
+		t.Errorf("Expected summary %q, got %q", expectedSummary, summary)
+	}
+}
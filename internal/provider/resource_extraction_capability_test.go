@@ -0,0 +1,74 @@
+// Copyright (c) Trifork
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccExtractionCapabilityResource_basic(t *testing.T) {
+	if os.Getenv("CORAX_API_ENDPOINT") == "" || os.Getenv("CORAX_API_KEY") == "" {
+		t.Skip("Skipping acceptance test: CORAX_API_ENDPOINT or CORAX_API_KEY not set")
+	}
+
+	resourceName := "corax_extraction_capability.test"
+	capabilityName := "tf-acc-test-extraction-cap-basic"
+	systemPrompt := "Extract the invoice number and total."
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccExtractionCapabilityResourceConfig(capabilityName, systemPrompt),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", capabilityName),
+					resource.TestCheckResourceAttr(resourceName, "system_prompt", systemPrompt),
+					resource.TestCheckResourceAttr(resourceName, "semantic_id", "tf-acc-test-extraction"),
+					resource.TestCheckResourceAttr(resourceName, "type", "extraction"),
+					resource.TestCheckResourceAttr(resourceName, "output_type", "text"), // Default
+					resource.TestCheckResourceAttr(resourceName, "is_public", "false"),  // Default
+					resource.TestCheckResourceAttr(resourceName, "config.temperature", "0.2"),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccExtractionCapabilityResourceConfig(capabilityName+"-updated", systemPrompt+" Also extract the due date."),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", capabilityName+"-updated"),
+					resource.TestCheckResourceAttr(resourceName, "system_prompt", systemPrompt+" Also extract the due date."),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccExtractionCapabilityResourceConfig(name, systemPrompt string) string {
+	return fmt.Sprintf(`
+provider "corax" {}
+
+resource "corax_extraction_capability" "test" {
+  name          = "%s"
+  semantic_id   = "tf-acc-test-extraction"
+  system_prompt = "%s"
+
+  config = {
+    temperature = 0.2
+  }
+}
+`, name, systemPrompt)
+}
@@ -0,0 +1,280 @@
+// Copyright (c) Trifork
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-corax/internal/coraxclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &KnowledgeCollectionResource{}
+var _ resource.ResourceWithImportState = &KnowledgeCollectionResource{}
+
+func NewKnowledgeCollectionResource() resource.Resource {
+	return &KnowledgeCollectionResource{}
+}
+
+// KnowledgeCollectionResource defines the resource implementation.
+type KnowledgeCollectionResource struct {
+	client *coraxclient.Client
+}
+
+// KnowledgeCollectionResourceModel describes the resource data model.
+// Based on openapi.json components.schemas.Collection.
+type KnowledgeCollectionResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Description    types.String `tfsdk:"description"`
+	IsPublic       types.Bool   `tfsdk:"is_public"`
+	ProjectID      types.String `tfsdk:"project_id"` // Nullable
+	CreatedBy      types.String `tfsdk:"created_by"`
+	CreatedAt      types.String `tfsdk:"created_at"`
+	Owner          types.String `tfsdk:"owner"`
+	DocumentsCount types.Int64  `tfsdk:"documents_count"`
+}
+
+// Helper function to map API Collection to Terraform model.
+func mapCollectionToModel(collection *coraxclient.Collection, model *KnowledgeCollectionResourceModel) {
+	model.ID = types.StringValue(collection.ID)
+	model.Name = types.StringValue(collection.Name)
+	if collection.Description != nil && *collection.Description != "" {
+		model.Description = types.StringValue(*collection.Description)
+	} else {
+		model.Description = types.StringNull()
+	}
+	model.IsPublic = types.BoolValue(collection.IsPublic)
+	if collection.ProjectID != nil && *collection.ProjectID != "" {
+		model.ProjectID = types.StringValue(*collection.ProjectID)
+	} else {
+		model.ProjectID = types.StringNull()
+	}
+	model.CreatedBy = types.StringValue(collection.CreatedBy)
+	model.CreatedAt = types.StringValue(collection.CreatedAt)
+	model.Owner = types.StringValue(collection.Owner)
+	model.DocumentsCount = types.Int64Value(int64(collection.DocumentsCount))
+}
+
+func (r *KnowledgeCollectionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_knowledge_collection"
+}
+
+func (r *KnowledgeCollectionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Corax Knowledge Collection. Collections group documents that chat capabilities can use for retrieval augmented generation (RAG).",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier for the collection (UUID).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the collection. Must be at least 1 character long.",
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "An optional description for the collection.",
+			},
+			"is_public": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true, // API defaults to false if not provided
+				MarkdownDescription: "Indicates whether the collection is public. Defaults to false.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The UUID of the project this collection belongs to.",
+			},
+			"created_by": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the user who created the collection.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The date and time the collection was created (RFC3339 format).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"owner": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The owner of the collection.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"documents_count": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The number of documents in the collection.",
+			},
+		},
+	}
+}
+
+func (r *KnowledgeCollectionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*coraxclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *coraxclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *KnowledgeCollectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data KnowledgeCollectionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating Knowledge Collection with name: %s", data.Name.ValueString()))
+
+	collectionCreatePayload := coraxclient.CollectionCreate{
+		Name: data.Name.ValueString(),
+	}
+	if !data.Description.IsNull() && !data.Description.IsUnknown() {
+		desc := data.Description.ValueString()
+		collectionCreatePayload.Description = &desc
+	}
+	if !data.IsPublic.IsNull() && !data.IsPublic.IsUnknown() {
+		isPublic := data.IsPublic.ValueBool()
+		collectionCreatePayload.IsPublic = &isPublic
+	}
+	if !data.ProjectID.IsNull() && !data.ProjectID.IsUnknown() {
+		projectID := data.ProjectID.ValueString()
+		collectionCreatePayload.ProjectID = &projectID
+	}
+
+	createdCollection, err := r.client.CreateCollection(ctx, collectionCreatePayload)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create knowledge collection, got error: %s", err))
+		return
+	}
+
+	mapCollectionToModel(createdCollection, &data)
+	tflog.Info(ctx, fmt.Sprintf("Knowledge Collection created successfully with ID: %s", createdCollection.ID))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *KnowledgeCollectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data KnowledgeCollectionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	collectionID := data.ID.ValueString()
+	tflog.Debug(ctx, fmt.Sprintf("Reading Knowledge Collection with ID: %s", collectionID))
+
+	collection, err := r.client.GetCollection(ctx, collectionID)
+	if err != nil {
+		if errors.Is(err, coraxclient.ErrNotFound) {
+			tflog.Warn(ctx, fmt.Sprintf("Knowledge Collection with ID %s not found, removing from state", collectionID))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read knowledge collection %s, got error: %s", collectionID, err))
+		return
+	}
+
+	mapCollectionToModel(collection, &data)
+	tflog.Debug(ctx, fmt.Sprintf("Successfully read Knowledge Collection with ID: %s", collectionID))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *KnowledgeCollectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan KnowledgeCollectionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state KnowledgeCollectionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	collectionID := state.ID.ValueString() // ID comes from state, not plan
+	tflog.Debug(ctx, fmt.Sprintf("Updating Knowledge Collection with ID: %s", collectionID))
+
+	collectionUpdatePayload := coraxclient.CollectionUpdate{
+		Name:     plan.Name.ValueString(),
+		IsPublic: plan.IsPublic.ValueBool(),
+	}
+	if !plan.Description.IsNull() && !plan.Description.IsUnknown() {
+		desc := plan.Description.ValueString()
+		collectionUpdatePayload.Description = &desc
+	}
+	if !plan.ProjectID.IsNull() && !plan.ProjectID.IsUnknown() {
+		projectID := plan.ProjectID.ValueString()
+		collectionUpdatePayload.ProjectID = &projectID
+	}
+
+	updatedCollection, err := r.client.UpdateCollection(ctx, collectionID, collectionUpdatePayload)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update knowledge collection %s, got error: %s", collectionID, err))
+		return
+	}
+
+	mapCollectionToModel(updatedCollection, &plan) // Update plan with response
+	tflog.Info(ctx, fmt.Sprintf("Knowledge Collection updated successfully with ID: %s", collectionID))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *KnowledgeCollectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data KnowledgeCollectionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	collectionID := data.ID.ValueString()
+	tflog.Debug(ctx, fmt.Sprintf("Deleting Knowledge Collection with ID: %s", collectionID))
+
+	err := r.client.DeleteCollection(ctx, collectionID)
+	if err != nil {
+		if errors.Is(err, coraxclient.ErrNotFound) {
+			tflog.Warn(ctx, fmt.Sprintf("Knowledge Collection with ID %s already deleted, removing from state", collectionID))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete knowledge collection %s, got error: %s", collectionID, err))
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Knowledge Collection with ID %s deleted successfully", collectionID))
+}
+
+func (r *KnowledgeCollectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,508 @@
+// Copyright (c) Trifork
+
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-corax/internal/coraxclient"
+	api "terraform-provider-corax/internal/generated"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &KnowledgeDocumentResource{}
+var _ resource.ResourceWithImportState = &KnowledgeDocumentResource{}
+var _ resource.ResourceWithModifyPlan = &KnowledgeDocumentResource{}
+
+func NewKnowledgeDocumentResource() resource.Resource {
+	return &KnowledgeDocumentResource{}
+}
+
+// KnowledgeDocumentResource defines the resource implementation.
+type KnowledgeDocumentResource struct {
+	client *coraxclient.Client
+}
+
+// KnowledgeDocumentResourceModel describes the resource data model.
+// Based on openapi.json components.schemas.Document.
+type KnowledgeDocumentResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	CollectionID     types.String `tfsdk:"collection_id"`
+	SourcePath       types.String `tfsdk:"source_path"`
+	Content          types.String `tfsdk:"content"`
+	Filename         types.String `tfsdk:"filename"`
+	Language         types.String `tfsdk:"language"`
+	DocumentMetadata types.Map    `tfsdk:"document_metadata"` // Map of string to string
+	ContentHash      types.String `tfsdk:"content_hash"`
+	Checksum         types.String `tfsdk:"checksum"`
+	Version          types.Int64  `tfsdk:"version"`
+	MimeType         types.String `tfsdk:"mime_type"`
+	FileSize         types.Int64  `tfsdk:"file_size"`
+	DateAdded        types.String `tfsdk:"date_added"`
+	LastUpdated      types.String `tfsdk:"last_updated"`
+}
+
+// Helper function to map API Document to Terraform model.
+// Filename is only filled in when unset (e.g. after import). Language and
+// document_metadata are only overwritten when the API reports them, since the
+// embed endpoint does not guarantee they are persisted.
+func mapDocumentToModel(ctx context.Context, doc *api.Document, model *KnowledgeDocumentResourceModel, diags *diag.Diagnostics) {
+	model.ID = types.StringValue(doc.GetId())
+	model.CollectionID = types.StringValue(doc.GetCollectionId())
+	// The API may store uploads under a generated name, so keep the configured filename.
+	if model.Filename.IsNull() || model.Filename.IsUnknown() {
+		if original, ok := doc.GetOriginalFilenameOk(); ok && original != nil && *original != "" {
+			model.Filename = types.StringValue(*original)
+		} else {
+			model.Filename = types.StringValue(doc.GetFilename())
+		}
+	}
+	if language, ok := doc.GetLanguageOk(); ok && language != nil && *language != "" {
+		model.Language = types.StringValue(*language)
+	}
+	if metadata, ok := doc.GetDocumentMetadataOk(); ok && len(metadata) > 0 {
+		stringMetadata := make(map[string]string, len(metadata))
+		for k, v := range metadata {
+			if s, isString := v.(string); isString {
+				stringMetadata[k] = s
+				continue
+			}
+			encoded, err := json.Marshal(v)
+			if err != nil {
+				diags.AddError("Metadata Conversion Error", fmt.Sprintf("Unable to encode document_metadata value for key %q: %s", k, err))
+				return
+			}
+			stringMetadata[k] = string(encoded)
+		}
+		metadataValue, mapDiags := types.MapValueFrom(ctx, types.StringType, stringMetadata)
+		diags.Append(mapDiags...)
+		model.DocumentMetadata = metadataValue
+	}
+	if checksum, ok := doc.GetChecksumOk(); ok && checksum != nil {
+		model.Checksum = types.StringValue(*checksum)
+	} else {
+		model.Checksum = types.StringNull()
+	}
+	model.Version = types.Int64Value(int64(doc.GetVersion()))
+	if mimeType, ok := doc.GetMimeTypeOk(); ok && mimeType != nil {
+		model.MimeType = types.StringValue(*mimeType)
+	} else {
+		model.MimeType = types.StringNull()
+	}
+	if fileSize, ok := doc.GetFileSizeOk(); ok && fileSize != nil {
+		model.FileSize = types.Int64Value(int64(*fileSize))
+	} else {
+		model.FileSize = types.Int64Null()
+	}
+	model.DateAdded = types.StringValue(doc.GetDateAdded().Format(time.RFC3339))
+	model.LastUpdated = types.StringValue(doc.GetLastUpdated().Format(time.RFC3339))
+}
+
+// readDocumentContent returns the bytes to upload, taken from either source_path or content.
+func readDocumentContent(model KnowledgeDocumentResourceModel) ([]byte, error) {
+	if !model.SourcePath.IsNull() {
+		return os.ReadFile(model.SourcePath.ValueString())
+	}
+	return []byte(model.Content.ValueString()), nil
+}
+
+// hashDocumentContent returns the hex encoded SHA-256 digest of the document content.
+func hashDocumentContent(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// documentChecksumDrifted reports whether the checksum reported by the API differs from the hash of the
+// local content. Checksums that aren't a SHA-256 hex digest can't be compared and never report drift.
+func documentChecksumDrifted(checksum types.String, contentHash string) bool {
+	if checksum.IsNull() || checksum.IsUnknown() {
+		return false
+	}
+	value := checksum.ValueString()
+	if len(value) != sha256.Size*2 {
+		return false
+	}
+	if _, err := hex.DecodeString(value); err != nil {
+		return false
+	}
+	return !strings.EqualFold(value, contentHash)
+}
+
+func (r *KnowledgeDocumentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_knowledge_document"
+}
+
+func (r *KnowledgeDocumentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a document in a Corax Knowledge Collection. The document is uploaded from a local file (`source_path`) or inline `content` and embedded into the collection. " +
+			"Changes to the local content, or to the server copy (detected through its checksum and version), plan a replacement of the document.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier for the document (UUID).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"collection_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The UUID of the knowledge collection the document belongs to. Changing this forces a new document to be created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_path": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Path to a local file to upload. Exactly one of `source_path` or `content` must be set.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("source_path"), path.MatchRoot("content")),
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"content": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Inline document content to upload. Requires `filename` to be set. Exactly one of `source_path` or `content` must be set.",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("filename")),
+				},
+			},
+			"filename": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The filename sent with the upload. Defaults to the base name of `source_path`. The extension determines how the API parses the document. Changing this forces a new document to be created.",
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"language": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The language of the document (e.g., 'en'). Changing this forces a new document to be created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"document_metadata": schema.MapAttribute{
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Arbitrary key/value metadata stored with the document. Changing this forces a new document to be created.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.UseStateForUnknown(),
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"content_hash": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "SHA-256 hash of the local content. Cleared when the server copy gets a new version, which plans a replacement.",
+			},
+			"checksum": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The checksum of the document as reported by the API. A SHA-256 checksum that differs from `content_hash` plans a replacement.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"version": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The version of the document as reported by the API.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"mime_type": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The MIME type of the document as detected by the API.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"file_size": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The size of the document in bytes.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"date_added": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The date and time the document was added (RFC3339 format).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The date and time the document was last updated (RFC3339 format).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *KnowledgeDocumentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*coraxclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *coraxclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+// ModifyPlan computes content_hash from the local content and plans a replacement
+// when it differs from the hash recorded in state or from the checksum of the server copy.
+func (r *KnowledgeDocumentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return // Resource is being destroyed
+	}
+
+	var plan KnowledgeDocumentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state *KnowledgeDocumentResourceModel
+	if !req.State.Raw.IsNull() {
+		state = &KnowledgeDocumentResourceModel{}
+		resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	var config KnowledgeDocumentResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Filename.IsNull() && !plan.SourcePath.IsUnknown() && !plan.SourcePath.IsNull() {
+		plan.Filename = types.StringValue(filepath.Base(plan.SourcePath.ValueString()))
+		if state != nil && !state.Filename.Equal(plan.Filename) {
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root("filename"))
+		}
+	}
+
+	if plan.SourcePath.IsUnknown() || plan.Content.IsUnknown() {
+		plan.ContentHash = types.StringUnknown()
+		if state != nil {
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root("content_hash"))
+		}
+	} else {
+		content, err := readDocumentContent(plan)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("source_path"), "Unable to Read Document", fmt.Sprintf("Unable to read %s: %s", plan.SourcePath.ValueString(), err))
+			return
+		}
+		plan.ContentHash = types.StringValue(hashDocumentContent(content))
+		if state != nil && !state.ContentHash.Equal(plan.ContentHash) {
+			tflog.Debug(ctx, fmt.Sprintf("Knowledge Document %s content hash changed, planning replacement", state.ID.ValueString()))
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root("content_hash"))
+		} else if state != nil && documentChecksumDrifted(state.Checksum, plan.ContentHash.ValueString()) {
+			tflog.Warn(ctx, fmt.Sprintf("Knowledge Document %s on the server (checksum %s) does not match the local content (%s), planning replacement",
+				state.ID.ValueString(), state.Checksum.ValueString(), plan.ContentHash.ValueString()))
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root("content_hash"))
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *KnowledgeDocumentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data KnowledgeDocumentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// source_path may only have become known during apply
+	if data.Filename.IsUnknown() || data.Filename.IsNull() {
+		data.Filename = types.StringValue(filepath.Base(data.SourcePath.ValueString()))
+	}
+
+	collectionID := data.CollectionID.ValueString()
+	tflog.Debug(ctx, fmt.Sprintf("Creating Knowledge Document %s in collection %s", data.Filename.ValueString(), collectionID))
+
+	content, err := readDocumentContent(data)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("source_path"), "Unable to Read Document", fmt.Sprintf("Unable to read %s: %s", data.SourcePath.ValueString(), err))
+		return
+	}
+
+	upload := coraxclient.DocumentUpload{
+		Filename: data.Filename.ValueString(),
+		Content:  content,
+	}
+	if !data.Language.IsNull() && !data.Language.IsUnknown() {
+		language := data.Language.ValueString()
+		upload.Language = &language
+	}
+	if !data.DocumentMetadata.IsNull() && !data.DocumentMetadata.IsUnknown() {
+		resp.Diagnostics.Append(data.DocumentMetadata.ElementsAs(ctx, &upload.DocumentMetadata, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	embedResult, err := r.client.EmbedDocument(ctx, collectionID, upload)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to upload knowledge document, got error: %s", err))
+		return
+	}
+	if len(embedResult.DocumentIds) == 0 {
+		resp.Diagnostics.AddError("Client Error", "Unable to upload knowledge document, the API did not return a document ID.")
+		return
+	}
+	documentID := embedResult.DocumentIds[0]
+
+	doc, err := r.client.GetDocument(ctx, collectionID, documentID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read knowledge document %s after upload, got error: %s", documentID, err))
+		return
+	}
+
+	if data.Language.IsUnknown() {
+		data.Language = types.StringNull()
+	}
+	if data.DocumentMetadata.IsUnknown() {
+		data.DocumentMetadata = types.MapNull(types.StringType)
+	}
+	mapDocumentToModel(ctx, doc, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.ContentHash = types.StringValue(hashDocumentContent(content))
+
+	tflog.Info(ctx, fmt.Sprintf("Knowledge Document created successfully with ID: %s", documentID))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *KnowledgeDocumentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data KnowledgeDocumentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	collectionID := data.CollectionID.ValueString()
+	documentID := data.ID.ValueString()
+	tflog.Debug(ctx, fmt.Sprintf("Reading Knowledge Document with ID: %s", documentID))
+
+	doc, err := r.client.GetDocument(ctx, collectionID, documentID)
+	if err != nil {
+		if errors.Is(err, coraxclient.ErrNotFound) {
+			tflog.Warn(ctx, fmt.Sprintf("Knowledge Document with ID %s not found, removing from state", documentID))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read knowledge document %s, got error: %s", documentID, err))
+		return
+	}
+
+	previousVersion := data.Version
+	mapDocumentToModel(ctx, doc, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The refreshed checksum is compared to the local content in ModifyPlan. A new version
+	// without a comparable checksum still means the server copy changed, so clear content_hash
+	// to let the next plan replace the document.
+	if !previousVersion.IsNull() && !previousVersion.Equal(data.Version) {
+		tflog.Warn(ctx, fmt.Sprintf("Knowledge Document %s changed on the server (version %d -> %d)",
+			documentID, previousVersion.ValueInt64(), data.Version.ValueInt64()))
+		data.ContentHash = types.StringNull()
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Successfully read Knowledge Document with ID: %s", documentID))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update only handles changes that do not alter the uploaded document, such as
+// moving source_path to a file with identical content.
+func (r *KnowledgeDocumentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan KnowledgeDocumentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating Knowledge Document with ID: %s", plan.ID.ValueString()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *KnowledgeDocumentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data KnowledgeDocumentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	collectionID := data.CollectionID.ValueString()
+	documentID := data.ID.ValueString()
+	tflog.Debug(ctx, fmt.Sprintf("Deleting Knowledge Document with ID: %s", documentID))
+
+	err := r.client.DeleteDocument(ctx, collectionID, documentID)
+	if err != nil {
+		if errors.Is(err, coraxclient.ErrNotFound) {
+			tflog.Warn(ctx, fmt.Sprintf("Knowledge Document with ID %s already deleted, removing from state", documentID))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete knowledge document %s, got error: %s", documentID, err))
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Knowledge Document with ID %s deleted successfully", documentID))
+}
+
+// ImportState imports a document using the format "collection_id/document_id".
+// The imported document has no recorded content_hash, so the first plan replaces it
+// with the configured content.
+func (r *KnowledgeDocumentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: collection_id/document_id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("collection_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+}
@@ -0,0 +1,160 @@
+// Copyright (c) Trifork
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccKnowledgeDocumentResource_sourcePath(t *testing.T) {
+	if os.Getenv("CORAX_API_ENDPOINT") == "" || os.Getenv("CORAX_API_KEY") == "" {
+		t.Skip("Skipping acceptance test: CORAX_API_ENDPOINT or CORAX_API_KEY not set")
+	}
+
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	collectionName := fmt.Sprintf("tf-acc-test-doc-collection-%s", rName)
+	resourceName := "corax_knowledge_document.test"
+
+	sourcePath := filepath.Join(t.TempDir(), "guide.md")
+	if err := os.WriteFile(sourcePath, []byte("# Guide\n\nFirst revision."), 0o600); err != nil {
+		t.Fatalf("Failed to write test document: %v", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccKnowledgeDocumentResourceConfig(collectionName, sourcePath),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "collection_id", "corax_knowledge_collection.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "filename", "guide.md"),
+					resource.TestCheckResourceAttr(resourceName, "language", "en"),
+					resource.TestCheckResourceAttr(resourceName, "document_metadata.team", "docs"),
+					resource.TestCheckResourceAttr(resourceName, "content_hash", hashDocumentContent([]byte("# Guide\n\nFirst revision."))),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "version"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName: resourceName,
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources[resourceName]
+					return fmt.Sprintf("%s/%s", rs.Primary.Attributes["collection_id"], rs.Primary.ID), nil
+				},
+				ImportStateVerify: true,
+				// Local inputs and the upload hash are not available from the API.
+				ImportStateVerifyIgnore: []string{"source_path", "content_hash"},
+			},
+			// Changing the local file plans a replacement
+			{
+				PreConfig: func() {
+					if err := os.WriteFile(sourcePath, []byte("# Guide\n\nSecond revision."), 0o600); err != nil {
+						t.Fatalf("Failed to rewrite test document: %v", err)
+					}
+				},
+				Config: testAccKnowledgeDocumentResourceConfig(collectionName, sourcePath),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.TestCheckResourceAttr(resourceName, "content_hash", hashDocumentContent([]byte("# Guide\n\nSecond revision."))),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccKnowledgeDocumentResource_inlineContent(t *testing.T) {
+	if os.Getenv("CORAX_API_ENDPOINT") == "" || os.Getenv("CORAX_API_KEY") == "" {
+		t.Skip("Skipping acceptance test: CORAX_API_ENDPOINT or CORAX_API_KEY not set")
+	}
+
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	collectionName := fmt.Sprintf("tf-acc-test-doc-collection-%s", rName)
+	resourceName := "corax_knowledge_document.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "corax" {}
+
+resource "corax_knowledge_collection" "test" {
+  name = "%[1]s"
+}
+
+resource "corax_knowledge_document" "test" {
+  collection_id = corax_knowledge_collection.test.id
+  filename      = "notes.txt"
+  content       = "Inline document content."
+}
+`, collectionName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "filename", "notes.txt"),
+					resource.TestCheckResourceAttr(resourceName, "content_hash", hashDocumentContent([]byte("Inline document content."))),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKnowledgeDocumentResourceConfig(collectionName, sourcePath string) string {
+	return fmt.Sprintf(`
+provider "corax" {}
+
+resource "corax_knowledge_collection" "test" {
+  name = "%[1]s"
+}
+
+resource "corax_knowledge_document" "test" {
+  collection_id = corax_knowledge_collection.test.id
+  source_path   = %[2]q
+  language      = "en"
+  document_metadata = {
+    team = "docs"
+  }
+}
+`, collectionName, sourcePath)
+}
+
+func TestDocumentChecksumDrifted(t *testing.T) {
+	contentHash := hashDocumentContent([]byte("hello"))
+
+	tests := []struct {
+		name     string
+		checksum types.String
+		expected bool
+	}{
+		{name: "matching checksum", checksum: types.StringValue(contentHash), expected: false},
+		{name: "matching uppercase checksum", checksum: types.StringValue(strings.ToUpper(contentHash)), expected: false},
+		{name: "different checksum", checksum: types.StringValue(hashDocumentContent([]byte("changed"))), expected: true},
+		{name: "not a SHA-256 digest", checksum: types.StringValue("5d41402abc4b2a76b9719d911017c592"), expected: false},
+		{name: "null checksum", checksum: types.StringNull(), expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := documentChecksumDrifted(tt.checksum, contentHash); got != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
@@ -26,6 +26,7 @@ import (
 
 var _ resource.Resource = &MCPServerResource{}
 var _ resource.ResourceWithImportState = &MCPServerResource{}
+var _ resource.ResourceWithModifyPlan = &MCPServerResource{}
 
 func NewMCPServerResource() resource.Resource {
 	return &MCPServerResource{}
@@ -279,6 +280,14 @@ func mapMCPServerToModel(server *coraxclient.MCPServer, model *MCPServerResource
 	return diags
 }
 
+// ModifyPlan refuses the resource when the server has the MCP servers feature disabled.
+func (r *MCPServerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	requireFeature(r.client, coraxclient.FeatureMCPServers, "corax_mcp_server", &resp.Diagnostics)
+}
+
 func (r *MCPServerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan MCPServerResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
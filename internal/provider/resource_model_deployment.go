@@ -26,6 +26,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &ModelDeploymentResource{}
 var _ resource.ResourceWithImportState = &ModelDeploymentResource{}
+var _ resource.ResourceWithModifyPlan = &ModelDeploymentResource{}
 
 func NewModelDeploymentResource() resource.Resource {
 	return &ModelDeploymentResource{}
@@ -80,7 +81,7 @@ func (r *ModelDeploymentResource) Schema(ctx context.Context, req resource.Schem
 			"configuration": schema.MapAttribute{
 				ElementType:         types.StringType, // Assuming string values for simplicity. API says object with additionalProperties.
 				Required:            true,
-				MarkdownDescription: "Configuration key-value pairs specific to the model deployment (e.g., model name, API version for Azure OpenAI).",
+				MarkdownDescription: "Configuration key-value pairs specific to the model deployment (e.g., model name, API version for Azure OpenAI). Keys and values are checked at plan time against the `deployment_configuration_fields` of the model provider's type, see the `corax_model_provider_type` data source.",
 				PlanModifiers:       []planmodifier.Map{mapplanmodifier.RequiresReplace()},
 			},
 			"is_active": schema.BoolAttribute{
@@ -110,6 +111,44 @@ func (r *ModelDeploymentResource) Configure(ctx context.Context, req resource.Co
 	r.client = client
 }
 
+// ModifyPlan validates the configuration against the deployment configuration fields of the model
+// provider's type: unknown keys, missing required fields and malformed values are reported before
+// apply. It's skipped while provider_id is unknown, e.g. when the model provider is created in the same run.
+func (r *ModelDeploymentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan ModelDeploymentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.ProviderID.IsUnknown() || plan.ProviderID.IsNull() || plan.Configuration.IsUnknown() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state ModelDeploymentResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() || (plan.ProviderID.Equal(state.ProviderID) && plan.Configuration.Equal(state.Configuration)) {
+			return
+		}
+	}
+
+	providerID := plan.ProviderID.ValueString()
+	provider, err := r.client.GetModelProvider(ctx, providerID)
+	if err != nil {
+		tflog.Debug(ctx, fmt.Sprintf("Unable to read model provider %s for configuration validation: %s", providerID, err))
+		return
+	}
+
+	fields, err := r.client.CachedModelDeploymentConfigurationFields(ctx, provider.ProviderType)
+	if err != nil {
+		resp.Diagnostics.AddWarning("Unable to Validate Configuration",
+			fmt.Sprintf("Unable to read the deployment configuration fields of provider type %s, configuration was not validated: %s", provider.ProviderType, err))
+		return
+	}
+	validateStringConfigurationFields(fields, plan.Configuration, path.Root("configuration"), &resp.Diagnostics)
+}
+
 // Helper to map TF model to API Create struct.
 func modelDeploymentResourceModelToAPICreate(ctx context.Context, plan ModelDeploymentResourceModel, diags *diag.Diagnostics) (*coraxclient.ModelDeploymentCreate, error) {
 	apiCreate := &coraxclient.ModelDeploymentCreate{
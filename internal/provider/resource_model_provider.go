@@ -26,6 +26,7 @@ const apiKeyConfigurationKey = "api_key"
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &ModelProviderResource{}
 var _ resource.ResourceWithImportState = &ModelProviderResource{}
+var _ resource.ResourceWithModifyPlan = &ModelProviderResource{}
 
 func NewModelProviderResource() resource.Resource {
 	return &ModelProviderResource{}
@@ -70,7 +71,7 @@ func (r *ModelProviderResource) Schema(ctx context.Context, req resource.SchemaR
 			"configuration": schema.MapAttribute{
 				ElementType:         types.StringType,
 				Required:            true,
-				MarkdownDescription: "Configuration key-value pairs for the model provider. Specific keys depend on the `provider_type`. For example, 'api_key', 'api_endpoint'. Some values may be sensitive.",
+				MarkdownDescription: "Configuration key-value pairs for the model provider. Specific keys depend on the `provider_type`. For example, 'api_key', 'api_endpoint'. Some values may be sensitive. Keys and values are checked at plan time against the `configuration_fields` of the provider type, see the `corax_model_provider_type` data source.",
 				Sensitive:           true, // Mark the whole map as sensitive as it often contains API keys.
 			},
 		},
@@ -89,6 +90,43 @@ func (r *ModelProviderResource) Configure(ctx context.Context, req resource.Conf
 	r.client = client
 }
 
+// ModifyPlan validates the configuration against the configuration fields of the provider type:
+// unknown keys, missing required fields and malformed values are reported before apply. It runs
+// here rather than in ValidateConfig because it needs the configured client.
+func (r *ModelProviderResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan ModelProviderResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.ProviderType.IsUnknown() || plan.ProviderType.IsNull() || plan.Configuration.IsUnknown() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state ModelProviderResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() || (plan.ProviderType.Equal(state.ProviderType) && plan.Configuration.Equal(state.Configuration)) {
+			return
+		}
+	}
+
+	providerType := plan.ProviderType.ValueString()
+	fields, err := r.client.CachedModelProviderConfigurationFields(ctx, providerType)
+	if err != nil {
+		if errors.Is(err, coraxclient.ErrNotFound) {
+			resp.Diagnostics.AddAttributeError(path.Root("provider_type"), "Unsupported Provider Type",
+				fmt.Sprintf("The provider type %q is not offered by the Corax API. Use the corax_model_provider_types data source to list the supported types.", providerType))
+			return
+		}
+		resp.Diagnostics.AddWarning("Unable to Validate Configuration",
+			fmt.Sprintf("Unable to read the configuration fields of provider type %s, configuration was not validated: %s", providerType, err))
+		return
+	}
+	validateStringConfigurationFields(fields, plan.Configuration, path.Root("configuration"), &resp.Diagnostics)
+}
+
 // Helper to map TF model to API Create struct.
 func modelProviderResourceModelToAPICreate(ctx context.Context, plan ModelProviderResourceModel, diags *diag.Diagnostics) (*coraxclient.ModelProviderCreate, error) {
 	apiCreate := &coraxclient.ModelProviderCreate{
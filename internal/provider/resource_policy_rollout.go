@@ -0,0 +1,320 @@
+// Copyright (c) Trifork
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-corax/internal/coraxclient"
+	api "terraform-provider-corax/internal/generated"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PolicyRolloutResource{}
+var _ resource.ResourceWithModifyPlan = &PolicyRolloutResource{}
+
+func NewPolicyRolloutResource() resource.Resource {
+	return &PolicyRolloutResource{}
+}
+
+// PolicyRolloutResource defines the resource implementation.
+type PolicyRolloutResource struct {
+	client *coraxclient.Client
+}
+
+// PolicyRolloutResourceModel describes the resource data model.
+// Based on components.schemas.PolicyImpactResponse and the response of POST /v1/compliance/policies/{policy_id}/applications.
+type PolicyRolloutResourceModel struct {
+	ID                    types.String `tfsdk:"id"`
+	PolicyID              types.String `tfsdk:"policy_id"`
+	MaxNonCompliant       types.Int64  `tfsdk:"max_non_compliant"`
+	Triggers              types.Map    `tfsdk:"triggers"`
+	AffectedAssets        types.Int64  `tfsdk:"affected_assets"`
+	NonCompliantAssets    types.Int64  `tfsdk:"non_compliant_assets"`
+	TouchedCapabilityIDs  types.List   `tfsdk:"touched_capability_ids"` // List of strings
+	ApplicationResultJSON types.String `tfsdk:"application_result_json"`
+}
+
+// policyRolloutExceedsLimit reports whether the number of non-compliant assets is above the configured limit.
+// Without a limit, the rollout is never blocked.
+func policyRolloutExceedsLimit(impact *api.PolicyImpactResponse, maxNonCompliant types.Int64) bool {
+	if maxNonCompliant.IsNull() || maxNonCompliant.IsUnknown() {
+		return false
+	}
+	return int64(impact.NonCompliantAssets) > maxNonCompliant.ValueInt64()
+}
+
+// addPolicyRolloutImpactDiagnostics reports the impact of rolling out a policy, as an error when it exceeds max_non_compliant.
+func addPolicyRolloutImpactDiagnostics(policyID string, impact *api.PolicyImpactResponse, maxNonCompliant types.Int64, diags *diag.Diagnostics) {
+	if policyRolloutExceedsLimit(impact, maxNonCompliant) {
+		diags.AddAttributeError(path.Root("max_non_compliant"), "Policy Rollout Exceeds Non-Compliant Limit",
+			fmt.Sprintf("Rolling out compliance policy %s affects %d assets, %d of which are non-compliant. This is more than the configured max_non_compliant of %d.",
+				policyID, impact.AffectedAssets, impact.NonCompliantAssets, maxNonCompliant.ValueInt64()))
+		return
+	}
+	diags.AddWarning("Policy Rollout Impact",
+		fmt.Sprintf("Rolling out compliance policy %s affects %d assets, %d of which are non-compliant and will have the policy's controls applied.",
+			policyID, impact.AffectedAssets, impact.NonCompliantAssets))
+}
+
+// nonCompliantCapabilityIDs returns the IDs of the capabilities that don't comply with a policy, i.e. the capabilities
+// rolling the policy out applies its controls to. The result is sorted and free of duplicates.
+func nonCompliantCapabilityIDs(assets []api.AssetResponse, policyID string) []string {
+	seen := map[string]struct{}{}
+	for _, asset := range assets {
+		for _, result := range asset.PolicyResults {
+			if result.PolicyId == policyID && !result.Compliant {
+				seen[asset.CapabilityId] = struct{}{}
+			}
+		}
+	}
+
+	ids := make([]string, 0, len(seen))
+	for id := range seen {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// policyRolloutReplaced reports whether the plan rolls the policy out again, i.e. whether policy_id or triggers changed.
+// The framework doesn't pass attribute-level RequiresReplace paths to ModifyPlan, so the inputs are compared directly.
+func policyRolloutReplaced(plan, state PolicyRolloutResourceModel) bool {
+	return !plan.PolicyID.Equal(state.PolicyID) || !plan.Triggers.Equal(state.Triggers)
+}
+
+func (r *PolicyRolloutResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policy_rollout"
+}
+
+func (r *PolicyRolloutResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Rolls a Corax Compliance Policy out to every non-compliant capability in its scope. " +
+			"The plan reports how many assets the policy affects, and fails when more than `max_non_compliant` of them are non-compliant. " +
+			"The rollout runs again when `policy_id` or `triggers` change. Destroying the resource only removes it from the Terraform state, the applied controls are kept.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the rollout, equal to `policy_id`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"policy_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The UUID of the compliance policy to roll out. Changing this rolls out the new policy.",
+				Validators:          []validator.String{uuidValidator()},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"max_non_compliant": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "The maximum number of non-compliant assets the rollout may touch. When the policy's impact exceeds it, plan and apply fail instead of warning.",
+				Validators:          []validator.Int64{int64validator.AtLeast(0)},
+			},
+			"triggers": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Arbitrary values that roll the policy out again when they change, e.g. the policy's controls.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"affected_assets": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The number of assets in the policy's scope at the time of the rollout.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"non_compliant_assets": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The number of non-compliant assets at the time of the rollout.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"touched_capability_ids": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: "The IDs of the capabilities that didn't comply with the policy before the rollout, and had its controls applied, sorted.",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"application_result_json": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The raw response of the rollout as a JSON string.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *PolicyRolloutResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*coraxclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *coraxclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+// ModifyPlan reports the impact of the rollout whenever the plan will roll the policy out.
+func (r *PolicyRolloutResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+	requireFeature(r.client, coraxclient.FeatureCompliance, "corax_policy_rollout", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan PolicyRolloutResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// An in-place update only changes max_non_compliant, nothing is rolled out.
+	if !req.State.Raw.IsNull() {
+		var state PolicyRolloutResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !policyRolloutReplaced(plan, state) {
+			return
+		}
+	}
+	if plan.PolicyID.IsUnknown() || plan.MaxNonCompliant.IsUnknown() {
+		return
+	}
+
+	policyID := plan.PolicyID.ValueString()
+	impact, err := r.client.GetCompliancePolicyImpact(ctx, policyID)
+	if err != nil {
+		resp.Diagnostics.AddWarning("Unable to Determine Policy Impact",
+			fmt.Sprintf("Unable to read the impact of compliance policy %s, it is checked again at apply time: %s", policyID, err))
+		return
+	}
+	addPolicyRolloutImpactDiagnostics(policyID, impact, plan.MaxNonCompliant, &resp.Diagnostics)
+}
+
+func (r *PolicyRolloutResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan PolicyRolloutResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policyID := plan.PolicyID.ValueString()
+
+	// The impact may have changed since the plan, check the limit again before touching any capability.
+	impact, err := r.client.GetCompliancePolicyImpact(ctx, policyID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read impact of compliance policy %s, got error: %s", policyID, err))
+		return
+	}
+	if policyRolloutExceedsLimit(impact, plan.MaxNonCompliant) {
+		addPolicyRolloutImpactDiagnostics(policyID, impact, plan.MaxNonCompliant, &resp.Diagnostics)
+		return
+	}
+
+	// The rollout response doesn't list the changed capabilities, so determine them before applying.
+	assets, err := r.client.ListComplianceAssets(ctx, "", "")
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list compliance assets for compliance policy %s, got error: %s", policyID, err))
+		return
+	}
+	touched, diags := types.ListValueFrom(ctx, types.StringType, nonCompliantCapabilityIDs(assets, policyID))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Rolling out Compliance Policy %s to %d non-compliant assets", policyID, impact.NonCompliantAssets))
+	result, err := r.client.ApplyCompliancePolicyToAll(ctx, policyID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to roll out compliance policy %s, got error: %s", policyID, err))
+		return
+	}
+
+	plan.ID = types.StringValue(policyID)
+	plan.AffectedAssets = types.Int64Value(int64(impact.AffectedAssets))
+	plan.NonCompliantAssets = types.Int64Value(int64(impact.NonCompliantAssets))
+	plan.TouchedCapabilityIDs = touched
+	plan.ApplicationResultJSON = types.StringValue(string(result.Raw))
+
+	tflog.Trace(ctx, fmt.Sprintf("Rolled out Compliance Policy %s to %d capabilities: %s", policyID, len(touched.Elements()), result.Message))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *PolicyRolloutResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PolicyRolloutResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A rollout is a one-off action, only check that the policy still exists.
+	policyID := data.PolicyID.ValueString()
+	_, err := r.client.GetCompliancePolicy(ctx, policyID)
+	if err != nil {
+		if errors.Is(err, coraxclient.ErrNotFound) {
+			tflog.Warn(ctx, fmt.Sprintf("Compliance Policy %s not found, removing rollout from state", policyID))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read compliance policy %s, got error: %s", policyID, err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update only stores a changed max_non_compliant, the other inputs force a new rollout.
+func (r *PolicyRolloutResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan PolicyRolloutResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *PolicyRolloutResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data PolicyRolloutResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Applied controls stay in place, use corax_capability_policy_attachment to manage them per capability.
+	tflog.Info(ctx, fmt.Sprintf("Rollout of Compliance Policy %s removed from state", data.PolicyID.ValueString()))
+}
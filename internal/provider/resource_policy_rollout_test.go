@@ -0,0 +1,160 @@
+// Copyright (c) Trifork
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	api "terraform-provider-corax/internal/generated"
+)
+
+func TestAccPolicyRolloutResource_basic(t *testing.T) {
+	if os.Getenv("CORAX_API_ENDPOINT") == "" || os.Getenv("CORAX_API_KEY") == "" {
+		t.Skip("Skipping acceptance test: CORAX_API_ENDPOINT or CORAX_API_KEY not set")
+	}
+
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	name := fmt.Sprintf("tf-acc-test-policy-rollout-%s", rName)
+	resourceName := "corax_policy_rollout.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The capability is non-compliant, so a limit of zero blocks the rollout
+			{
+				Config:      testAccPolicyRolloutResourceConfig(name, 0),
+				ExpectError: regexp.MustCompile("Policy Rollout Exceeds Non-Compliant Limit"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccPolicyRolloutResourceConfig(name, 10),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "policy_id", "corax_compliance_policy.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "max_non_compliant", "10"),
+					resource.TestCheckResourceAttrSet(resourceName, "affected_assets"),
+					resource.TestCheckResourceAttrSet(resourceName, "application_result_json"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccPolicyRolloutResourceConfig(name string, maxNonCompliant int) string {
+	return fmt.Sprintf(`
+provider "corax" {}
+
+resource "corax_project" "test" {
+  name = %[1]q
+}
+
+resource "corax_chat_capability" "test" {
+  name          = %[1]q
+  project_id    = corax_project.test.id
+  system_prompt = "You are a helpful assistant."
+}
+
+resource "corax_compliance_policy" "test" {
+  name       = %[1]q
+  scope_type = "project"
+  scope_id   = corax_project.test.id
+
+  prompt_shield {}
+}
+
+resource "corax_policy_rollout" "test" {
+  policy_id         = corax_compliance_policy.test.id
+  max_non_compliant = %[2]d
+
+  depends_on = [corax_chat_capability.test]
+}
+`, name, maxNonCompliant)
+}
+
+func TestNonCompliantCapabilityIDs(t *testing.T) {
+	assets := []api.AssetResponse{
+		{CapabilityId: "cap-2", PolicyResults: []api.PolicyComplianceResult{{PolicyId: "policy-1", Compliant: false}}},
+		{CapabilityId: "cap-1", PolicyResults: []api.PolicyComplianceResult{
+			{PolicyId: "policy-2", Compliant: false},
+			{PolicyId: "policy-1", Compliant: false},
+		}},
+		{CapabilityId: "cap-3", PolicyResults: []api.PolicyComplianceResult{{PolicyId: "policy-1", Compliant: true}}},
+		{CapabilityId: "cap-4", PolicyResults: []api.PolicyComplianceResult{{PolicyId: "policy-2", Compliant: false}}},
+		{CapabilityId: "cap-5"},
+	}
+
+	if got := nonCompliantCapabilityIDs(assets, "policy-1"); !reflect.DeepEqual(got, []string{"cap-1", "cap-2"}) {
+		t.Errorf("Expected [cap-1 cap-2], got %v", got)
+	}
+	if got := nonCompliantCapabilityIDs(nil, "policy-1"); !reflect.DeepEqual(got, []string{}) {
+		t.Errorf("Expected no IDs, got %v", got)
+	}
+}
+
+func TestAddPolicyRolloutImpactDiagnostics(t *testing.T) {
+	impact := &api.PolicyImpactResponse{AffectedAssets: 10, NonCompliantAssets: 3}
+
+	var diags diag.Diagnostics
+	addPolicyRolloutImpactDiagnostics("policy-1", impact, types.Int64Null(), &diags)
+	if diags.HasError() || diags.WarningsCount() != 1 {
+		t.Errorf("Expected a single warning without a limit, got %v", diags)
+	}
+
+	diags = nil
+	addPolicyRolloutImpactDiagnostics("policy-1", impact, types.Int64Value(3), &diags)
+	if diags.HasError() || diags.WarningsCount() != 1 {
+		t.Errorf("Expected a single warning at the limit, got %v", diags)
+	}
+
+	diags = nil
+	addPolicyRolloutImpactDiagnostics("policy-1", impact, types.Int64Value(2), &diags)
+	if diags.ErrorsCount() != 1 || diags.WarningsCount() != 0 {
+		t.Errorf("Expected a single error above the limit, got %v", diags)
+	}
+}
+
+func TestPolicyRolloutReplaced(t *testing.T) {
+	triggers := func(value string) types.Map {
+		return types.MapValueMust(types.StringType, map[string]attr.Value{"controls": types.StringValue(value)})
+	}
+	state := PolicyRolloutResourceModel{
+		PolicyID:        types.StringValue("policy-1"),
+		MaxNonCompliant: types.Int64Value(5),
+		Triggers:        triggers("v1"),
+	}
+
+	plan := state
+	plan.MaxNonCompliant = types.Int64Value(10)
+	if policyRolloutReplaced(plan, state) {
+		t.Errorf("Expected a changed max_non_compliant not to roll out again")
+	}
+
+	plan = state
+	plan.PolicyID = types.StringValue("policy-2")
+	if !policyRolloutReplaced(plan, state) {
+		t.Errorf("Expected a changed policy_id to roll out again")
+	}
+
+	plan = state
+	plan.Triggers = triggers("v2")
+	if !policyRolloutReplaced(plan, state) {
+		t.Errorf("Expected changed triggers to roll out again")
+	}
+
+	plan = state
+	plan.Triggers = types.MapNull(types.StringType)
+	if !policyRolloutReplaced(plan, state) {
+		t.Errorf("Expected removed triggers to roll out again")
+	}
+}
@@ -0,0 +1,324 @@
+// Copyright (c) Trifork
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-corax/internal/coraxclient"
+	api "terraform-provider-corax/internal/generated"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RoleResource{}
+var _ resource.ResourceWithImportState = &RoleResource{}
+var _ resource.ResourceWithModifyPlan = &RoleResource{}
+
+func NewRoleResource() resource.Resource {
+	return &RoleResource{}
+}
+
+// RoleResource defines the resource implementation.
+type RoleResource struct {
+	client *coraxclient.Client
+}
+
+// RoleResourceModel describes the resource data model.
+// Based on components.schemas.Role.
+type RoleResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"` // Nullable
+	Permissions types.Set    `tfsdk:"permissions"` // Set of strings
+	IsBuiltIn   types.Bool   `tfsdk:"is_built_in"`
+	IsSuperuser types.Bool   `tfsdk:"is_superuser"`
+	CreatedBy   types.String `tfsdk:"created_by"` // Nullable
+	CreatedAt   types.String `tfsdk:"created_at"`
+	UpdatedAt   types.String `tfsdk:"updated_at"`
+}
+
+// mapRoleToModel maps an API role onto the Terraform model.
+func mapRoleToModel(ctx context.Context, role *api.Role, model *RoleResourceModel, diags *diag.Diagnostics) {
+	model.ID = types.StringValue(role.Id)
+	model.Name = types.StringValue(role.Name)
+	model.Description = types.StringPointerValue(role.Description.Get())
+
+	permissions, d := types.SetValueFrom(ctx, types.StringType, enumStrings(role.Permissions))
+	diags.Append(d...)
+	model.Permissions = permissions
+
+	model.IsBuiltIn = types.BoolValue(role.IsBuiltIn)
+	model.IsSuperuser = types.BoolValue(role.IsSuperuser)
+	model.CreatedBy = types.StringPointerValue(role.CreatedBy.Get())
+	model.CreatedAt = types.StringValue(role.CreatedAt.Format(time.RFC3339))
+	model.UpdatedAt = types.StringValue(role.UpdatedAt.Format(time.RFC3339))
+}
+
+// rolePermissionsToAPI converts the permissions set to API permissions, sorted for a stable payload.
+func rolePermissionsToAPI(ctx context.Context, permissions types.Set, diags *diag.Diagnostics) []api.Permission {
+	var values []string
+	diags.Append(permissions.ElementsAs(ctx, &values, false)...)
+	sort.Strings(values)
+
+	result := make([]api.Permission, 0, len(values))
+	for _, v := range values {
+		result = append(result, api.Permission(v))
+	}
+	return result
+}
+
+func (r *RoleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role"
+}
+
+func (r *RoleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a custom Corax RBAC role, a named set of permissions that can be assigned to users.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier for the role (UUID).",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the role.",
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "An optional description of the role.",
+			},
+			"permissions": schema.SetAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				MarkdownDescription: "The permissions granted by the role, e.g. `projects:read`. " +
+					"Each permission is checked at plan time against the permissions offered by the API.",
+			},
+			"is_built_in": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the role is built into Corax.",
+				PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"is_superuser": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the role grants superuser access.",
+				PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"created_by": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the user who created the role.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"created_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The date and time the role was created (RFC3339 format).",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"updated_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The date and time the role was last updated (RFC3339 format).",
+			},
+		},
+	}
+}
+
+func (r *RoleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*coraxclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *coraxclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+// ModifyPlan checks the planned permissions against the permissions published by the API.
+func (r *RoleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan RoleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.Permissions.IsNull() || plan.Permissions.IsUnknown() {
+		return
+	}
+
+	var permissions []string
+	resp.Diagnostics.Append(plan.Permissions.ElementsAs(ctx, &permissions, false)...)
+	if resp.Diagnostics.HasError() || len(permissions) == 0 {
+		return
+	}
+
+	available, err := r.client.ListPermissions(ctx)
+	if err != nil {
+		resp.Diagnostics.AddWarning("Unable to Validate Permissions",
+			fmt.Sprintf("Unable to list permissions, role permissions were not validated: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(validateRolePermissions(permissions, available)...)
+}
+
+// validateRolePermissions returns an error for every permission that is not offered by the API.
+func validateRolePermissions(permissions []string, available []api.PermissionItem) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	known := make(map[string]bool, len(available))
+	ids := make([]string, 0, len(available))
+	for _, p := range available {
+		known[p.Id] = true
+		ids = append(ids, p.Id)
+	}
+	sort.Strings(ids)
+
+	for _, permission := range permissions {
+		if !known[permission] {
+			diags.AddAttributeError(path.Root("permissions"), "Unsupported Permission",
+				fmt.Sprintf("Permission %q is not offered by the Corax API. Available permissions: %s.", permission, strings.Join(ids, ", ")))
+		}
+	}
+	return diags
+}
+
+func (r *RoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RoleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating role with name: %s", data.Name.ValueString()))
+
+	payload := api.RoleCreate{
+		Name:        data.Name.ValueString(),
+		Permissions: rolePermissionsToAPI(ctx, data.Permissions, &resp.Diagnostics),
+	}
+	if !data.Description.IsNull() && !data.Description.IsUnknown() {
+		payload.SetDescription(data.Description.ValueString())
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	role, err := r.client.CreateRole(ctx, payload)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create role, got error: %s", err))
+		return
+	}
+
+	mapRoleToModel(ctx, role, &data, &resp.Diagnostics)
+	tflog.Info(ctx, fmt.Sprintf("Role created successfully with ID: %s", role.Id))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RoleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roleID := data.ID.ValueString()
+	tflog.Debug(ctx, fmt.Sprintf("Reading role with ID: %s", roleID))
+
+	role, err := r.client.GetRole(ctx, roleID)
+	if err != nil {
+		if errors.Is(err, coraxclient.ErrNotFound) {
+			tflog.Warn(ctx, fmt.Sprintf("Role with ID %s not found, removing from state", roleID))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read role %s, got error: %s", roleID, err))
+		return
+	}
+
+	mapRoleToModel(ctx, role, &data, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state RoleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roleID := state.ID.ValueString()
+	tflog.Debug(ctx, fmt.Sprintf("Updating role with ID: %s", roleID))
+
+	payload := api.RoleUpdate{
+		Name:        plan.Name.ValueString(),
+		Permissions: rolePermissionsToAPI(ctx, plan.Permissions, &resp.Diagnostics),
+	}
+	// The update replaces the role, so an omitted description clears it.
+	if plan.Description.IsNull() {
+		payload.SetDescriptionNil()
+	} else {
+		payload.SetDescription(plan.Description.ValueString())
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	role, err := r.client.UpdateRole(ctx, roleID, payload)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update role %s, got error: %s", roleID, err))
+		return
+	}
+
+	mapRoleToModel(ctx, role, &plan, &resp.Diagnostics)
+	tflog.Info(ctx, fmt.Sprintf("Role updated successfully with ID: %s", roleID))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *RoleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RoleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roleID := data.ID.ValueString()
+	tflog.Debug(ctx, fmt.Sprintf("Deleting role with ID: %s", roleID))
+
+	err := r.client.DeleteRole(ctx, roleID)
+	if err != nil {
+		if errors.Is(err, coraxclient.ErrNotFound) {
+			tflog.Warn(ctx, fmt.Sprintf("Role with ID %s already deleted, removing from state", roleID))
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete role %s, got error: %s", roleID, err))
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Role with ID %s deleted successfully", roleID))
+}
+
+func (r *RoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
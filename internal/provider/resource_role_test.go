@@ -0,0 +1,110 @@
+// Copyright (c) Trifork
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	api "terraform-provider-corax/internal/generated"
+)
+
+func TestAccRoleResource_basic(t *testing.T) {
+	if os.Getenv("CORAX_API_ENDPOINT") == "" || os.Getenv("CORAX_API_KEY") == "" {
+		t.Skip("Skipping acceptance test: CORAX_API_ENDPOINT or CORAX_API_KEY not set")
+	}
+
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	name := fmt.Sprintf("tf-acc-test-role-%s", rName)
+	resourceName := "corax_role.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unknown permissions are rejected at plan time
+			{
+				Config:      testAccRoleResourceConfig(name, `"projects:read", "projects:admin"`),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value Match`),
+			},
+			// Create and Read testing
+			{
+				Config: testAccRoleResourceConfig(name, `"projects:read", "capabilities:read"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "permissions.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "permissions.*", "capabilities:read"),
+					resource.TestCheckResourceAttr(resourceName, "is_built_in", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccRoleResourceConfig(name+"-updated", `"projects:read"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name+"-updated"),
+					resource.TestCheckResourceAttr(resourceName, "permissions.#", "1"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccRoleResourceConfig(name string, permissions string) string {
+	return fmt.Sprintf(`
+provider "corax" {}
+
+resource "corax_role" "test" {
+  name        = %[1]q
+  description = "Managed by Terraform acceptance tests"
+  permissions = [%[2]s]
+}
+`, name, permissions)
+}
+
+func TestValidateRolePermissions(t *testing.T) {
+	available := []api.PermissionItem{
+		{Id: "projects:read", Description: "Read projects", Category: "projects"},
+		{Id: "projects:write", Description: "Write projects", Category: "projects"},
+	}
+
+	if diags := validateRolePermissions([]string{"projects:read", "projects:write"}, available); diags.HasError() {
+		t.Errorf("Expected no errors, got %v", diags)
+	}
+
+	diags := validateRolePermissions([]string{"projects:read", "users:manage"}, available)
+	if diags.ErrorsCount() != 1 {
+		t.Fatalf("Expected 1 error, got %v", diags)
+	}
+	if detail := diags.Errors()[0].Detail(); !regexp.MustCompile(`"users:manage".*projects:read, projects:write`).MatchString(detail) {
+		t.Errorf("Unexpected detail: %s", detail)
+	}
+}
+
+func TestRolePermissionsToAPI(t *testing.T) {
+	var diags diag.Diagnostics
+	set, _ := types.SetValueFrom(context.Background(), types.StringType, []string{"users:manage", "projects:read"})
+
+	result := rolePermissionsToAPI(context.Background(), set, &diags)
+
+	expected := []api.Permission{api.PROJECTS_READ, api.USERS_MANAGE}
+	if diags.HasError() || !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v (%v)", expected, result, diags)
+	}
+}
@@ -0,0 +1,267 @@
+// Copyright (c) Trifork
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-corax/internal/coraxclient"
+	api "terraform-provider-corax/internal/generated"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &UserRoleAssignmentResource{}
+var _ resource.ResourceWithImportState = &UserRoleAssignmentResource{}
+
+func NewUserRoleAssignmentResource() resource.Resource {
+	return &UserRoleAssignmentResource{}
+}
+
+// UserRoleAssignmentResource defines the resource implementation.
+// Users are provisioned by the identity provider, so the resource only manages the role and
+// active flag of an existing user, and restores the values it found on destroy.
+type UserRoleAssignmentResource struct {
+	client *coraxclient.Client
+}
+
+// UserRoleAssignmentResourceModel describes the resource data model.
+// Based on components.schemas.UserRBAC and components.schemas.UserUpdate.
+type UserRoleAssignmentResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	UserID           types.String `tfsdk:"user_id"`
+	RoleID           types.String `tfsdk:"role_id"`
+	RoleName         types.String `tfsdk:"role_name"`
+	IsActive         types.Bool   `tfsdk:"is_active"`
+	Username         types.String `tfsdk:"username"`
+	PreviousRoleID   types.String `tfsdk:"previous_role_id"`
+	PreviousIsActive types.Bool   `tfsdk:"previous_is_active"`
+}
+
+// mapUserToRoleAssignmentModel copies the role and active flag of an API user onto the model.
+// The previous_* attributes are left untouched.
+func mapUserToRoleAssignmentModel(user *api.UserRBAC, model *UserRoleAssignmentResourceModel) {
+	model.ID = types.StringValue(user.Id)
+	model.UserID = types.StringValue(user.Id)
+	model.RoleID = types.StringValue(user.RoleId)
+	model.RoleName = types.StringValue(user.RoleName)
+	model.IsActive = types.BoolValue(user.IsActive)
+	model.Username = types.StringValue(user.Username)
+}
+
+// roleAssignmentUpdateFromPlan builds the user update for the planned role and active flag.
+// Exactly one of role_id and role_name is configured; the other is unknown in the plan.
+func roleAssignmentUpdateFromPlan(plan UserRoleAssignmentResourceModel) api.UserUpdate {
+	update := api.UserUpdate{}
+	if !plan.RoleID.IsNull() && !plan.RoleID.IsUnknown() {
+		update.SetRoleId(plan.RoleID.ValueString())
+	} else if !plan.RoleName.IsNull() && !plan.RoleName.IsUnknown() {
+		update.SetRoleName(plan.RoleName.ValueString())
+	}
+	if !plan.IsActive.IsNull() && !plan.IsActive.IsUnknown() {
+		update.SetIsActive(plan.IsActive.ValueBool())
+	}
+	return update
+}
+
+func (r *UserRoleAssignmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_role_assignment"
+}
+
+func (r *UserRoleAssignmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Assigns a role to an existing Corax user and optionally activates or deactivates the user. " +
+			"The role and active flag the user had before the assignment are recorded and restored when the resource is destroyed.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the assignment, equal to `user_id`.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"user_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the user.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"role_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The UUID of the role to assign. Exactly one of `role_id` and `role_name` must be set.",
+				Validators: []validator.String{
+					uuidValidator(),
+					stringvalidator.ExactlyOneOf(path.MatchRoot("role_id"), path.MatchRoot("role_name")),
+				},
+			},
+			"role_name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The name of the role to assign. Exactly one of `role_id` and `role_name` must be set.",
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"is_active": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether the user is active. Left unchanged when not set.",
+				PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"username": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The username of the user.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"previous_role_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The role the user had before the assignment was created, restored on destroy. For imported assignments this is the role at import time.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"previous_is_active": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the user was active before the assignment was created, restored on destroy.",
+				PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+}
+
+func (r *UserRoleAssignmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*coraxclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *coraxclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *UserRoleAssignmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan UserRoleAssignmentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userID := plan.UserID.ValueString()
+	tflog.Debug(ctx, fmt.Sprintf("Assigning role to user %s", userID))
+
+	current, err := r.client.GetUser(ctx, userID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read user %s, got error: %s", userID, err))
+		return
+	}
+
+	user, err := r.client.UpdateUser(ctx, userID, roleAssignmentUpdateFromPlan(plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to assign role to user %s, got error: %s", userID, err))
+		return
+	}
+
+	mapUserToRoleAssignmentModel(user, &plan)
+	plan.PreviousRoleID = types.StringValue(current.RoleId)
+	plan.PreviousIsActive = types.BoolValue(current.IsActive)
+	tflog.Info(ctx, fmt.Sprintf("Role %s assigned to user %s (previous role %s)", user.RoleId, userID, current.RoleId))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *UserRoleAssignmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state UserRoleAssignmentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userID := state.ID.ValueString()
+	tflog.Debug(ctx, fmt.Sprintf("Reading role assignment of user %s", userID))
+
+	user, err := r.client.GetUser(ctx, userID)
+	if err != nil {
+		if errors.Is(err, coraxclient.ErrNotFound) {
+			tflog.Warn(ctx, fmt.Sprintf("User %s not found, removing role assignment from state", userID))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read user %s, got error: %s", userID, err))
+		return
+	}
+
+	mapUserToRoleAssignmentModel(user, &state)
+	// After import there is no record of the prior role, so destroy keeps the role found at import.
+	if state.PreviousRoleID.IsNull() {
+		state.PreviousRoleID = types.StringValue(user.RoleId)
+		state.PreviousIsActive = types.BoolValue(user.IsActive)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *UserRoleAssignmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan UserRoleAssignmentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userID := plan.UserID.ValueString()
+	tflog.Debug(ctx, fmt.Sprintf("Updating role assignment of user %s", userID))
+
+	user, err := r.client.UpdateUser(ctx, userID, roleAssignmentUpdateFromPlan(plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to assign role to user %s, got error: %s", userID, err))
+		return
+	}
+
+	mapUserToRoleAssignmentModel(user, &plan)
+	tflog.Info(ctx, fmt.Sprintf("Role %s assigned to user %s", user.RoleId, userID))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete restores the role and active flag the user had before the assignment.
+func (r *UserRoleAssignmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state UserRoleAssignmentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userID := state.ID.ValueString()
+	tflog.Debug(ctx, fmt.Sprintf("Restoring role %s of user %s", state.PreviousRoleID.ValueString(), userID))
+
+	update := api.UserUpdate{}
+	update.SetRoleId(state.PreviousRoleID.ValueString())
+	if !state.PreviousIsActive.IsNull() {
+		update.SetIsActive(state.PreviousIsActive.ValueBool())
+	}
+
+	_, err := r.client.UpdateUser(ctx, userID, update)
+	if err != nil {
+		if errors.Is(err, coraxclient.ErrNotFound) {
+			tflog.Warn(ctx, fmt.Sprintf("User %s no longer exists, removing role assignment from state", userID))
+			return
+		}
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to restore previous role %s of user %s, got error: %s", state.PreviousRoleID.ValueString(), userID, err))
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Role assignment of user %s removed, previous role %s restored", userID, state.PreviousRoleID.ValueString()))
+}
+
+func (r *UserRoleAssignmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
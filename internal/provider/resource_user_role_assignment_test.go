@@ -0,0 +1,99 @@
+// Copyright (c) Trifork
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUserRoleAssignmentResource_basic(t *testing.T) {
+	if os.Getenv("CORAX_API_ENDPOINT") == "" || os.Getenv("CORAX_API_KEY") == "" {
+		t.Skip("Skipping acceptance test: CORAX_API_ENDPOINT or CORAX_API_KEY not set")
+	}
+
+	// Users are provisioned by the identity provider, so the test needs an existing user
+	userID := os.Getenv("CORAX_TEST_USER_ID")
+	if userID == "" {
+		t.Skip("Skipping acceptance test: CORAX_TEST_USER_ID must be set to the ID of an existing, non-superuser user")
+	}
+
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	name := fmt.Sprintf("tf-acc-test-role-%s", rName)
+	resourceName := "corax_user_role_assignment.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccUserRoleAssignmentResourceConfig(name, userID, "role_id = corax_role.test.id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "user_id", userID),
+					resource.TestCheckResourceAttrPair(resourceName, "role_id", "corax_role.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "role_name", name),
+					resource.TestCheckResourceAttrSet(resourceName, "previous_role_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "username"),
+				),
+			},
+			// Switching to role_name for the same role is a no-op
+			{
+				Config:   testAccUserRoleAssignmentResourceConfig(name, userID, "role_name = corax_role.test.name"),
+				PlanOnly: true,
+			},
+			// Delete testing automatically occurs in TestCase and restores previous_role_id
+		},
+	})
+}
+
+func testAccUserRoleAssignmentResourceConfig(name string, userID string, roleAttribute string) string {
+	return fmt.Sprintf(`
+provider "corax" {}
+
+resource "corax_role" "test" {
+  name        = %[1]q
+  permissions = ["projects:read"]
+}
+
+resource "corax_user_role_assignment" "test" {
+  user_id = %[2]q
+  %[3]s
+}
+`, name, userID, roleAttribute)
+}
+
+func TestRoleAssignmentUpdateFromPlan(t *testing.T) {
+	t.Run("by role name", func(t *testing.T) {
+		plan := UserRoleAssignmentResourceModel{
+			RoleID:   types.StringUnknown(),
+			RoleName: types.StringValue("Viewer"),
+			IsActive: types.BoolUnknown(),
+		}
+
+		update := roleAssignmentUpdateFromPlan(plan)
+
+		if update.RoleId.IsSet() || update.GetRoleName() != "Viewer" || update.IsActive.IsSet() {
+			t.Errorf("Unexpected update: %+v", update)
+		}
+	})
+
+	t.Run("by role ID with active flag", func(t *testing.T) {
+		plan := UserRoleAssignmentResourceModel{
+			RoleID:   types.StringValue("role-1"),
+			RoleName: types.StringUnknown(),
+			IsActive: types.BoolValue(false),
+		}
+
+		update := roleAssignmentUpdateFromPlan(plan)
+
+		if update.GetRoleId() != "role-1" || update.RoleName.IsSet() || !update.IsActive.IsSet() || update.GetIsActive() {
+			t.Errorf("Unexpected update: %+v", update)
+		}
+	})
+}